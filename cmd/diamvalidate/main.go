@@ -0,0 +1,104 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Validates diameter messages against dictionaries.
+// Use: diamvalidate [-builtin=false] [-dict a.xml,b.xml] msg [msg ...]
+//
+// Each file contains one or more messages, in binary or hex, e.g. as
+// copied from a trace. A file named "-" is read from stdin. Messages
+// are checked against the rules of their command by
+// diam.Message.ValidateAll: missing required AVPs, AVPs occurring more
+// than allowed, AVPs not expected in the command or Grouped AVP, and
+// AVP flags or vendors that differ from the dictionary. AVPs that fail
+// to decode are reported with their offset in the message, and the AVPs
+// before them are checked as well. Problems are
+// printed one per line, prefixed with the file name and message number,
+// and the exit status is 1 if any problem is found.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func main() {
+	builtin := flag.Bool("builtin", true, "load the built-in dictionaries")
+	files := flag.String("dict", "", "comma separated list of dictionaries")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] msg [msg ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	d, err := loadDict(*builtin, *files)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	status := 0
+	for _, name := range flag.Args() {
+		var b []byte
+		if name == "-" {
+			b, err = ioutil.ReadAll(os.Stdin)
+		} else {
+			b, err = ioutil.ReadFile(name)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		msgs, err := readMessages(decodeInput(b), d)
+		if err != nil {
+			fmt.Printf("%s: %v\n", name, err)
+			status = 1
+		}
+		for n, m := range msgs {
+			if m.err != nil {
+				fmt.Printf("%s: message %d: %v\n", name, n+1, m.err)
+				status = 1
+			}
+			for _, p := range m.ValidateAll(nil) {
+				fmt.Printf("%s: message %d: %s\n", name, n+1, p)
+				status = 1
+			}
+		}
+	}
+	os.Exit(status)
+}
+
+// loadDict returns a Parser with the built-in dictionaries, if builtin
+// is set, and the comma separated list of files.
+func loadDict(builtin bool, files string) (*dict.Parser, error) {
+	d, err := dict.NewParser()
+	if err != nil {
+		return nil, err
+	}
+	if builtin {
+		var b bytes.Buffer
+		if err := dict.DefaultParser().Dump(&b, dict.XML); err != nil {
+			return nil, err
+		}
+		if err := d.Load(&b); err != nil {
+			return nil, err
+		}
+	}
+	if files != "" {
+		for _, f := range strings.Split(files, ",") {
+			if err := d.LoadFile(f); err != nil {
+				return nil, fmt.Errorf("%s: %v", f, err)
+			}
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Message checks.  Part of go-diameter.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// decodeInput returns the messages in b, which is either binary or
+// hex, optionally with spaces, newlines or colons between bytes.
+func decodeInput(b []byte) []byte {
+	s := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n', ':':
+			return -1
+		}
+		return r
+	}, string(b))
+	s = strings.TrimPrefix(s, "0x")
+	if h, err := hex.DecodeString(s); err == nil && len(h) > 0 {
+		return h
+	}
+	return b
+}
+
+// message is a message read from the input, along with the error of
+// the AVP that failed to decode, if any.
+type message struct {
+	*diam.Message
+	err *diam.ErrDecodeAVP
+}
+
+// readMessages decodes all messages in b. Messages with an AVP that
+// fails to decode are returned with the AVPs before it and its error,
+// and decoding continues with the next message. Decoding stops at the
+// first message that cannot be read, such as a truncated message or
+// one of an unknown command, which is returned with its error.
+func readMessages(b []byte, d *dict.Parser) ([]message, error) {
+	var msgs []message
+	r := bytes.NewReader(b)
+	for r.Len() > 0 {
+		m, err := diam.ReadMessage(r, d)
+		if derr, ok := err.(*diam.ErrDecodeAVP); ok && m != nil {
+			msgs = append(msgs, message{m, derr})
+			continue
+		}
+		if err != nil {
+			return msgs, fmt.Errorf("message %d: %v", len(msgs)+1, err)
+		}
+		msgs = append(msgs, message{Message: m})
+	}
+	return msgs, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func newCCR() *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, 4, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("cli;1"))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("cli"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("test"))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity("test"))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4))
+	m.NewAVP(avp.ServiceContextID, avp.Mbit, 0, datatype.UTF8String("test@example.com"))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(1))
+	m.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned32(0))
+	return m
+}
+
+func TestValidate(t *testing.T) {
+	m := newCCR()
+	if p := m.ValidateAll(nil); len(p) != 0 {
+		t.Fatalf("Unexpected problems in valid CCR: %v", p)
+	}
+	m = diam.NewRequest(diam.CreditControl, 4, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("cli;1"))
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("cli;2"))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("cli"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("test"))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4))
+	m.NewAVP(avp.ServiceContextID, avp.Mbit, 0, datatype.UTF8String("test@example.com"))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(1))
+	m.NewAVP(avp.CCRequestNumber, 0, 0, datatype.Unsigned32(0))
+	m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(1)),
+		},
+	})
+	m.NewAVP(avp.ResultCode, avp.Mbit, 0, datatype.Unsigned32(diam.Success))
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Hex as copied from a trace.
+	msgs, err := readMessages(decodeInput([]byte(hex.EncodeToString(b)+"\n")), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("Unexpected # of messages. Want 1, have %d", len(msgs))
+	}
+	var have []string
+	for _, p := range msgs[0].ValidateAll(nil) {
+		have = append(have, p.Error())
+	}
+	want := []string{
+		"CCR: Session-Id is not the first AVP",
+		"CCR/CC-Request-Number: M-bit must be set",
+		"CCR/Subscription-Id: missing required AVP Subscription-Id-Data",
+		"CCR: AVP Result-Code is not allowed",
+		"CCR: AVP Session-Id occurs 2 times, max 1",
+		"CCR: missing required AVP Destination-Realm",
+	}
+	if strings.Join(have, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("Unexpected problems.\nWant:\n%s\nHave:\n%s",
+			strings.Join(want, "\n"), strings.Join(have, "\n"))
+	}
+}
+
+func TestReadMessages(t *testing.T) {
+	b, err := newCCR().Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	two := append(append([]byte(nil), b...), b...)
+	msgs, err := readMessages(decodeInput(two), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("Unexpected # of messages. Want 2, have %d", len(msgs))
+	}
+	if _, err = readMessages(two[:len(two)-4], dict.Default); err == nil {
+		t.Fatal("Truncated message was decoded")
+	}
+}
+
+func TestReadMessagesDecodeError(t *testing.T) {
+	m := newCCR()
+	m.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned64(1))
+	bad, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := newCCR().Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := readMessages(append(bad, b...), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("Unexpected # of messages. Want 2, have %d", len(msgs))
+	}
+	if msgs[0].err == nil || msgs[0].err.Offset != m.Len()-16 {
+		t.Fatalf("Unexpected error. Want AVP at offset %d, have %v", m.Len()-16, msgs[0].err)
+	}
+	if len(msgs[0].AVP) != 8 || msgs[1].err != nil {
+		t.Fatalf("Unexpected messages: %v", msgs)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Dictionary checks.  Part of go-diameter.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// builtinFile is the file name used for the built-in dictionaries, and
+// dictionaryFile for problems of the dictionary as a whole.
+const (
+	builtinFile    = "<builtin>"
+	dictionaryFile = "<dictionary>"
+)
+
+// A problem is an error found in a dictionary file.
+type problem struct {
+	File string
+	App  *dict.App
+	Msg  string
+}
+
+func (p problem) String() string {
+	if p.App.Name == "" {
+		return fmt.Sprintf("%s: application %d: %s", p.File, p.App.ID, p.Msg)
+	}
+	return fmt.Sprintf("%s: application %d (%s): %s", p.File, p.App.ID, p.App.Name, p.Msg)
+}
+
+type nameIdx struct {
+	appID uint32
+	name  string
+}
+
+// def is the definition of a named item with a code.
+type def struct {
+	file string
+	name string
+	code uint32
+}
+
+// linter checks dictionary files. All files are loaded before being
+// checked, together with the built-in dictionaries, so that rules can
+// reference AVPs of other files.
+//
+// The checks of the dictionary as loaded by a Parser, such as rules
+// referencing unknown AVPs or codes used twice, are done by
+// dict.Validate. The linter adds checks of the definitions themselves.
+type linter struct {
+	builtin []*dict.App
+	files   []string
+	apps    map[string][]*dict.App // applications of each file
+	avpname map[nameIdx]def
+	vendor  map[uint32]def
+}
+
+func newLinter() *linter {
+	return &linter{
+		apps:    make(map[string][]*dict.App),
+		avpname: make(map[nameIdx]def),
+		vendor:  make(map[uint32]def),
+	}
+}
+
+// addBuiltin adds the given applications, which are loaded before the
+// files. They are only checked by dict.Validate.
+func (l *linter) addBuiltin(apps []*dict.App) {
+	l.builtin = append(l.builtin, apps...)
+	for _, app := range apps {
+		for _, avp := range app.AVP {
+			l.avpname[nameIdx{app.ID, avp.Name}] = def{builtinFile, avp.Name, avp.Code}
+		}
+		for _, v := range app.Vendor {
+			l.vendor[v.ID] = def{builtinFile, v.Name, v.ID}
+		}
+	}
+}
+
+// addFile loads a dictionary file to be checked.
+func (l *linter) addFile(name string) error {
+	fd, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	return l.add(name, fd)
+}
+
+// add loads the dictionary file name from r, in any format supported
+// by the dict package.
+func (l *linter) add(name string, r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f, err := dict.Decode(b)
+	if err != nil {
+		return err
+	}
+	l.files = append(l.files, name)
+	l.apps[name] = append(l.apps[name], f.App...)
+	return nil
+}
+
+// lint checks all files and returns the problems found.
+func (l *linter) lint() []problem {
+	apps := l.builtin
+	for _, file := range l.files {
+		apps = append(apps, l.apps[file]...)
+	}
+	var p []problem
+	for _, vp := range dict.Validate(apps).Problems {
+		file, app := l.source(vp)
+		p = append(p, problem{file, app, vp.Msg})
+	}
+	// Index definitions, reporting conflicts.
+	for _, file := range l.files {
+		for _, app := range l.apps[file] {
+			report := func(format string, a ...interface{}) {
+				p = append(p, problem{file, app, fmt.Sprintf(format, a...)})
+			}
+			for _, v := range app.Vendor {
+				if v.ID == 0 {
+					report("vendor %q has no id", v.Name)
+					continue
+				}
+				if d, ok := l.define(l.vendor, v.ID, def{file, v.Name, v.ID}); !ok {
+					report("vendor %d is named %q and %q in %s", v.ID, v.Name, d.name, d.file)
+				}
+			}
+			for _, avp := range app.AVP {
+				if d, ok := l.define(l.avpname, nameIdx{app.ID, avp.Name}, def{file, avp.Name, avp.Code}); !ok {
+					report("AVP %s has code %d and %d in %s", avp.Name, avp.Code, d.code, d.file)
+				}
+			}
+		}
+	}
+	// Check each definition.
+	for _, file := range l.files {
+		for _, app := range l.apps[file] {
+			report := func(format string, a ...interface{}) {
+				p = append(p, problem{file, app, fmt.Sprintf(format, a...)})
+			}
+			for _, cmd := range app.Command {
+				if cmd.Short == "" {
+					report("command %s has no short name", cmd.Name)
+				}
+				for _, msg := range l.checkRules(cmd.Request.Rule) {
+					report("%s-Request: %s", cmd.Name, msg)
+				}
+				for _, msg := range l.checkRules(cmd.Answer.Rule) {
+					report("%s-Answer: %s", cmd.Name, msg)
+				}
+			}
+			for _, avp := range app.AVP {
+				for _, msg := range l.checkAVP(avp) {
+					report("AVP %s (%d): %s", avp.Name, avp.Code, msg)
+				}
+			}
+		}
+	}
+	return p
+}
+
+// source returns the file and application of the item of a problem
+// found by dict.Validate: the last file that defines it, as later files
+// override earlier ones.
+func (l *linter) source(p *dict.Problem) (string, *dict.App) {
+	for n := len(l.files) - 1; n >= 0; n-- {
+		for _, app := range l.apps[l.files[n]] {
+			if app.ID == p.AppID && defines(app, p.Name) {
+				return l.files[n], app
+			}
+		}
+	}
+	for _, app := range l.builtin {
+		if app.ID == p.AppID && defines(app, p.Name) {
+			return builtinFile, app
+		}
+	}
+	return dictionaryFile, &dict.App{ID: p.AppID}
+}
+
+// defines reports whether app defines a command or AVP named name.
+func defines(app *dict.App, name string) bool {
+	for _, cmd := range app.Command {
+		if cmd.Name == name {
+			return true
+		}
+	}
+	for _, avp := range app.AVP {
+		if avp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// define adds d to the index unless it conflicts with an existing
+// definition, which is returned. Definitions of the same name in
+// different files do not conflict, as later files override earlier ones.
+func (l *linter) define(index interface{}, key interface{}, d def) (def, bool) {
+	var have def
+	var ok bool
+	switch idx := index.(type) {
+	case map[nameIdx]def:
+		if have, ok = idx[key.(nameIdx)]; !ok {
+			idx[key.(nameIdx)] = d
+		}
+	case map[uint32]def:
+		if have, ok = idx[key.(uint32)]; !ok {
+			idx[key.(uint32)] = d
+		}
+	}
+	if !ok {
+		return d, true
+	}
+	if have.name != d.name || have.code != d.code || have.file == d.file {
+		return have, false
+	}
+	return have, true
+}
+
+func (l *linter) checkAVP(avp *dict.AVP) []string {
+	var msgs []string
+	if strings.TrimSpace(avp.Name) != avp.Name {
+		msgs = append(msgs, fmt.Sprintf("name %q has surrounding spaces", avp.Name))
+	}
+	id, ok := datatype.Available[avp.Data.TypeName]
+	if !ok {
+		// Reported by dict.Validate.
+		return msgs
+	}
+	switch {
+	case id == datatype.EnumeratedType && len(avp.Data.Enum) == 0:
+		msgs = append(msgs, "Enumerated AVP has no items")
+	case id != datatype.EnumeratedType && len(avp.Data.Enum) > 0:
+		msgs = append(msgs, fmt.Sprintf("%s AVP has enumerated items", avp.Data.TypeName))
+	case id != datatype.GroupedType && len(avp.Data.Rule) > 0:
+		msgs = append(msgs, fmt.Sprintf("%s AVP has rules", avp.Data.TypeName))
+	}
+	codes := make(map[int32]string)
+	names := make(map[string]bool)
+	for _, item := range avp.Data.Enum {
+		if name, dup := codes[item.Code]; dup {
+			msgs = append(msgs, fmt.Sprintf("item %d is named %s and %s", item.Code, name, item.Name))
+		}
+		if names[item.Name] {
+			msgs = append(msgs, fmt.Sprintf("item %s is defined more than once", item.Name))
+		}
+		codes[item.Code] = item.Name
+		names[item.Name] = true
+	}
+	return append(msgs, l.checkRules(avp.Data.Rule)...)
+}
+
+// checkRules checks that rules are defined once and have valid bounds.
+// Rules referencing unknown AVPs are reported by dict.Validate.
+func (l *linter) checkRules(rules []*dict.Rule) []string {
+	var msgs []string
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if seen[rule.AVP] {
+			msgs = append(msgs, fmt.Sprintf("rule for %s is defined more than once", rule.AVP))
+		}
+		seen[rule.AVP] = true
+		if rule.Forbidden && (rule.Required || rule.Min > 0) {
+			msgs = append(msgs, fmt.Sprintf("rule for %s is both forbidden and required", rule.AVP))
+		}
+		if rule.Max > 0 && rule.Min > rule.Max {
+			msgs = append(msgs, fmt.Sprintf("rule for %s has min %d greater than max %d",
+				rule.AVP, rule.Min, rule.Max))
+		}
+	}
+	return msgs
+}
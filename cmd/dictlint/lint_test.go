@@ -0,0 +1,116 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+var testDict = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="1000" name="Test">
+		<vendor id="0" name="Nobody"/>
+		<vendor id="99999" name="Test"/>
+		<vendor id="99999" name="Other"/>
+		<command code="9000" short="TS" name="Test">
+			<request>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Test-Missing" required="true" max="1"/>
+				<rule avp="Test-Int" required="false" min="2" max="1"/>
+				<rule avp="Test-Bad" required="true" forbidden="true"/>
+			</request>
+			<answer>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Session-Id" required="true" max="1"/>
+			</answer>
+		</command>
+		<command code="9000" short="TD" name="Duplicate">
+		</command>
+		<avp name="Test-Int" code="9001" must="M">
+			<data type="Integer32"/>
+		</avp>
+		<avp name="Test-Dup" code="9001" must="M">
+			<data type="Integer32"/>
+		</avp>
+		<avp name="Test-Vendor" code="9001" must="V,M">
+			<data type="Integer32"/>
+		</avp>
+		<avp name="Test-Bad" code="9002" must="M">
+			<data type="Integer33"/>
+		</avp>
+		<avp name="Test-Enum " code="9003" must="M">
+			<data type="Enumerated">
+				<item code="1" name="ONE"/>
+				<item code="1" name="UNO"/>
+			</data>
+		</avp>
+		<avp name="Test-Empty" code="9004" must="M">
+			<data type="Enumerated"/>
+		</avp>
+	</application>
+</diameter>`
+
+func TestLint(t *testing.T) {
+	l := newLinter()
+	l.addBuiltin(dict.Default.Apps())
+	if err := l.add("test.xml", strings.NewReader(testDict)); err != nil {
+		t.Fatal(err)
+	}
+	var have []string
+	for _, p := range l.lint() {
+		have = append(have, p.String())
+	}
+	want := []string{
+		`command code 9000 is used by Test and Duplicate`,
+		`AVP code 9001 is used by Test-Int and Test-Dup`,
+		`AVP Test-Bad has unsupported data type "Integer33"`,
+		`Test-Request: rule references unknown AVP Test-Missing`,
+		`vendor "Nobody" has no id`,
+		`vendor 99999 is named "Other" and "Test" in test.xml`,
+		`Test-Request: rule for Test-Int has min 2 greater than max 1`,
+		`Test-Request: rule for Test-Bad is both forbidden and required`,
+		`Test-Answer: rule for Session-Id is defined more than once`,
+		`AVP Test-Enum  (9003): name "Test-Enum " has surrounding spaces`,
+		`AVP Test-Enum  (9003): item 1 is named ONE and UNO`,
+		`AVP Test-Empty (9004): Enumerated AVP has no items`,
+	}
+	if len(have) != len(want) {
+		t.Fatalf("Unexpected problems. Want %d, have %d:\n%s",
+			len(want), len(have), strings.Join(have, "\n"))
+	}
+	for n, p := range have {
+		if prefix := "test.xml: application 1000 (Test): "; p != prefix+want[n] {
+			t.Fatalf("Unexpected problem #%d.\nWant: %s%s\nHave: %s", n, prefix, want[n], p)
+		}
+	}
+}
+
+func TestLintTestdata(t *testing.T) {
+	l := newLinter()
+	l.addBuiltin(dict.Default.Apps())
+	for _, name := range []string{
+		"../../diam/dict/xml/base.xml",
+		"../../diam/dict/xml/credit_control.xml",
+		"../../diam/dict/xml/tgpp_s6a.xml",
+		"../../diam/dict/xml/tgpp_gx.xml",
+		"../../diam/dict/xml/tgpp_cx.xml",
+		"../../diam/dict/xml/tgpp_ro_rf.xml",
+		"../../diam/dict/xml/tgpp_rx.xml",
+		"../../diam/dict/xml/tgpp_s6b.xml",
+		"../../diam/dict/xml/tgpp_sh.xml",
+		"../../diam/dict/xml/tgpp_sta.xml",
+		"../../diam/dict/xml/tgpp_swx.xml",
+	} {
+		if err := l.addFile(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p := l.lint(); len(p) != 0 {
+		t.Fatalf("Unexpected problems: %v", p)
+	}
+}
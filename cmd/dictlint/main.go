@@ -0,0 +1,57 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Validates go-diameter dictionary files.
+// Use: dictlint [-builtin=false] [dict.xml ...]
+//
+// Files are checked as loaded together by a Parser, after the built-in
+// dictionaries unless -builtin=false, with dict.Validate for rules
+// referencing unknown AVPs, unsupported data types, duplicate codes and
+// missing base protocol AVPs. Each definition is also checked, e.g. for
+// duplicate enumerated items or rules. Files may be in any format
+// supported by the dict package. Without files, the built-in
+// dictionaries are checked.
+//
+// Problems are printed one per line, prefixed with the file name, and
+// the exit status is 1 if any problem is found.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func main() {
+	builtin := flag.Bool("builtin", true, "resolve references against the built-in dictionaries")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] [dict.xml ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if !*builtin && flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	l := newLinter()
+	if *builtin {
+		l.addBuiltin(dict.DefaultParser().Apps())
+	}
+	for _, name := range flag.Args() {
+		if err := l.addFile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+			os.Exit(1)
+		}
+	}
+	problems := l.lint()
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+}
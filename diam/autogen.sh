@@ -8,7 +8,7 @@
 # Run `sh autogen.sh` to re-generate these files after changing
 # dictionary XML files.
 
-dict=dict/testdata/*.xml
+dict=dict/xml/*.xml
 
 
 ## Generate commands.go
@@ -64,8 +64,8 @@ echo ')' >> $src
 go fmt $src
 
 
-## Generate dict/default.go
-src=dict/default.go
+## Generate avp/definitions.go
+src=avp/definitions.go
 
 cat << EOF > $src
 // Copyright 2013-2015 go-diameter authors. All rights reserved.
@@ -74,32 +74,48 @@ cat << EOF > $src
 
 // This file is auto-generated from our dictionaries.
 
-package dict
-
-import "bytes"
-
-// Default is a Parser object with pre-loaded
-// Base Protocol and Credit Control dictionaries.
-var Default *Parser
-
-func init() {
-	Default, _ = NewParser()
-	Default.Load(bytes.NewReader([]byte(baseXML)))
-	Default.Load(bytes.NewReader([]byte(creditcontrolXML)))
-	Default.Load(bytes.NewReader([]byte(tgpprorfXML)))
-}
+package avp
 
+// Vendor IDs.
+const (
 EOF
 
-for f in $dict
-do
+cat $dict | sed \
+	-e 's/-//g' \
+	-ne 's/.*<vendor id="\([0-9]*\)" name="\(.*\)".*/Vendor\2 = \1/p' \
+	| sort -u >> $src
 
-var=`basename $f | sed -e 's/\.xml/XML/g' -e 's/_//g'`
 cat << EOF >> $src
-var $var=\``cat $f`\`
+)
 
+// definitions of AVPs in our dictionaries, with their vendor and the
+// flags they must have. The vendor of AVPs that must have the 'V' bit
+// is the vendor of their application, unless set by vendor-id.
+var definitions = []Definition{
 EOF
 
-done
+cat $dict | awk '
+function attr(s, key) {
+	if (!match(s, " " key "=\"[^\"]*\""))
+		return ""
+	return substr(s, RSTART + length(key) + 3, RLENGTH - length(key) - 4)
+}
+/<application / { vendor = 0 }
+/<vendor / { vendor = attr($0, "id") }
+/<avp / {
+	must = attr($0, "must")
+	flags = ""
+	if (must ~ /M/) flags = "Mbit"
+	if (must ~ /V/) flags = flags (flags ? " | " : "") "Vbit"
+	if (must ~ /P/) flags = flags (flags ? " | " : "") "Pbit"
+	if (!flags) flags = "0"
+	v = (must ~ /V/) ? vendor : 0
+	if (attr($0, "vendor-id") != "") v = attr($0, "vendor-id")
+	printf "{Name: \"%s\", Code: %s, VendorID: %s, Flags: %s},\n", \
+		attr($0, "name"), attr($0, "code"), v, flags
+}' | sort -u >> $src
+
+echo '}' >> $src
 
 go fmt $src
+
@@ -9,9 +9,9 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // AVP is a Diameter attribute-value-pair.
@@ -35,6 +35,141 @@ func NewAVP(code uint32, flags uint8, vendor uint32, data datatype.Type) *AVP {
 	return a
 }
 
+// ErrDecodeAVP is returned when decoding the AVPs of a message or
+// Grouped AVP fails. Offset is the position of the AVP that failed,
+// in bytes from the start of the message, or from the start of the
+// data of the Grouped AVP. Errors in nested Grouped AVPs are wrapped
+// by the ErrDecodeAVP of each enclosing AVP.
+//
+// AVP holds the bytes of the AVP that failed as received, without
+// padding, or only its header when its length is invalid.
+type ErrDecodeAVP struct {
+	Offset int
+	AVP    []byte
+	Err    error
+}
+
+// newErrDecodeAVP returns an ErrDecodeAVP for the AVP at the start of
+// b, with a copy of its bytes.
+func newErrDecodeAVP(offset int, b []byte, err error) *ErrDecodeAVP {
+	n := len(b)
+	if n > 8 {
+		n = 8
+	}
+	if ok, l := rawAVPLength(b); ok {
+		n = l
+	}
+	return &ErrDecodeAVP{
+		Offset: offset,
+		AVP:    append([]byte(nil), b[:n]...),
+		Err:    err,
+	}
+}
+
+// rawAVPLength returns the length of the AVP at the start of b, and
+// whether it is valid: not shorter than the AVP header, nor longer
+// than b.
+func rawAVPLength(b []byte) (bool, int) {
+	if len(b) < 8 {
+		return false, 0
+	}
+	l := int(uint24to32(b[5:8]))
+	hdr := 8
+	if b[4]&avp.Vbit == avp.Vbit {
+		hdr = 12
+	}
+	return l >= hdr && l <= len(b), l
+}
+
+// Error implements the error interface.
+func (e *ErrDecodeAVP) Error() string {
+	return fmt.Sprintf("Failed to decode AVP at offset %d: %s", e.Offset, e.Err)
+}
+
+// innermost returns the ErrDecodeAVP of the AVP that failed, which is
+// nested in e when it failed in a Grouped AVP.
+func (e *ErrDecodeAVP) innermost() *ErrDecodeAVP {
+	for {
+		next, ok := e.Err.(*ErrDecodeAVP)
+		if !ok {
+			return e
+		}
+		e = next
+	}
+}
+
+// FailedAVP returns the AVP that failed to decode, with its data as
+// an OctetString, for the Failed-AVP of an error answer. For AVPs in
+// Grouped AVPs it is the innermost AVP that failed. See RFC 6733
+// section 7.5.
+func (e *ErrDecodeAVP) FailedAVP() *AVP {
+	b := e.innermost().AVP
+	if len(b) < 8 {
+		return nil
+	}
+	a := &AVP{
+		Code:  binary.BigEndian.Uint32(b[0:4]),
+		Flags: b[4],
+	}
+	payload := b[8:]
+	if a.Flags&avp.Vbit == avp.Vbit && len(b) >= 12 {
+		a.VendorID = binary.BigEndian.Uint32(b[8:12])
+		payload = b[12:]
+	}
+	a.Data = datatype.OctetString(payload)
+	a.Length = a.headerLen()
+	return a
+}
+
+// ResultCode returns the Result-Code for answering a request that
+// failed to decode: DIAMETER_INVALID_AVP_LENGTH when the length of the
+// AVP is invalid, DIAMETER_AVP_UNSUPPORTED when the AVP is not in the
+// dictionary and has the M-bit set, and DIAMETER_INVALID_AVP_VALUE
+// otherwise. Unknown AVPs without the M-bit do not fail to decode.
+func (e *ErrDecodeAVP) ResultCode() uint32 {
+	in := e.innermost()
+	if ok, _ := rawAVPLength(in.AVP); !ok {
+		return InvalidAVPLenght
+	}
+	if _, ok := in.Err.(*errUnknownAVP); ok {
+		return AVPUnsupported
+	}
+	return InvalidAVPValue
+}
+
+// errUnknownAVP is returned when decoding an AVP that is not in the
+// dictionary and has the M-bit set.
+type errUnknownAVP struct {
+	err error
+}
+
+// Error implements the error interface.
+func (e *errUnknownAVP) Error() string {
+	return e.err.Error()
+}
+
+// Clone returns a deep copy of the AVP, that can be modified or added
+// to another message without affecting the original AVP.
+func (a *AVP) Clone() *AVP {
+	c := *a
+	if a.Data != nil {
+		c.Data = datatype.Clone(a.Data)
+	}
+	return &c
+}
+
+// Equal reports whether a and b have the same code, flags, vendor id
+// and data. Data is compared by datatype.Equal.
+func (a *AVP) Equal(b *AVP) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Code == b.Code &&
+		a.Flags == b.Flags &&
+		a.VendorID == b.VendorID &&
+		datatype.Equal(a.Data, b.Data)
+}
+
 // DecodeAVP decodes the bytes of a Diameter AVP.
 // It uses the given application id and dictionary for decoding the bytes.
 func DecodeAVP(data []byte, application uint32, dictionary *dict.Parser) (*AVP, error) {
@@ -48,51 +183,58 @@ func DecodeAVP(data []byte, application uint32, dictionary *dict.Parser) (*AVP,
 // DecodeFromBytes decodes the bytes of a Diameter AVP.
 // It uses the given application id and dictionary for decoding the bytes.
 func (a *AVP) DecodeFromBytes(data []byte, application uint32, dictionary *dict.Parser) error {
+	return a.decode(data, application, dictionary, nil)
+}
+
+// decode decodes the bytes of a Diameter AVP according to opts.
+func (a *AVP) decode(data []byte, application uint32, dictionary *dict.Parser, opts *DecodeOptions) error {
 	dl := len(data)
 	if dl < 8 {
 		return fmt.Errorf("Not enough data to decode AVP header: %d bytes", dl)
 	}
 	a.Code = binary.BigEndian.Uint32(data[0:4])
-	// Find this code in the dictionary.
-	dictAVP, err := dictionary.FindAVP(application, a.Code)
-	if err != nil {
-		return err
-	}
 	a.Flags = data[4]
 	a.Length = int(uint24to32(data[5:8]))
-	if dl < int(a.Length) {
+	hdrLength := 8
+	if a.Flags&avp.Vbit == avp.Vbit {
+		hdrLength = 12
+	}
+	if a.Length < hdrLength {
+		return fmt.Errorf("Invalid AVP length: %d < %d", a.Length, hdrLength)
+	}
+	if dl < a.Length {
 		return fmt.Errorf("Not enough data to decode AVP: %d != %d",
 			dl, a.Length)
 	}
 	data = data[:a.Length] // this cuts padded bytes off
-	var hdrLength int
-	var payload []byte
 	// Read VendorId when required.
-	if a.Flags&avp.Vbit == avp.Vbit {
+	if hdrLength == 12 {
 		a.VendorID = binary.BigEndian.Uint32(data[8:12])
-		payload = data[12:]
-		hdrLength = 12
-	} else {
-		payload = data[8:]
-		hdrLength = 8
 	}
-	bodyLen := a.Length - hdrLength
-	if n := len(payload); n < bodyLen {
-		return fmt.Errorf(
-			"Not enough data to decode AVP: %d != %d",
-			hdrLength, n,
-		)
+	payload := data[hdrLength:]
+	var err error
+	if decode := findAVPDecoder(a.VendorID, a.Code); decode != nil {
+		a.Data, err = decode(payload)
+	} else {
+		// Find this code in the dictionary.
+		dictAVP, derr := dictionary.FindAVPWithVendor(application, a.Code, a.VendorID)
+		switch {
+		case derr == nil:
+			a.Data, err = opts.types().Decode(dictAVP.Data.Type, payload)
+		case a.Flags&avp.Mbit == 0:
+			// Unknown AVPs that are not mandatory are ignored, as
+			// in RFC 6733 section 4.1, and kept as OctetString.
+			a.Data, err = datatype.DecodeOctetString(payload)
+		default:
+			return &errUnknownAVP{derr}
+		}
 	}
-	a.Data, err = datatype.Decode(dictAVP.Data.Type, payload)
 	if err != nil {
 		return err
 	}
 	// Handle grouped AVPs.
-	if a.Data.Type() == datatype.GroupedType {
-		a.Data, err = DecodeGrouped(
-			a.Data.(datatype.Grouped),
-			application, dictionary,
-		)
+	if g, ok := a.Data.(datatype.Grouped); ok {
+		a.Data, err = decodeGrouped(g, application, dictionary, opts)
 		if err != nil {
 			return err
 		}
@@ -101,11 +243,19 @@ func (a *AVP) DecodeFromBytes(data []byte, application uint32, dictionary *dict.
 }
 
 // Serialize returns the byte sequence that represents this AVP.
-// It requires at least the Code, Flags and Data fields set.
+// It requires at least the Code, Flags and Data fields set. AVPs with
+// datatype.Repeated data are serialized as one AVP per value.
 func (a *AVP) Serialize() ([]byte, error) {
 	if a.Data == nil {
 		return nil, errors.New("Failed to serialize AVP: Data is nil")
 	}
+	if _, ok := a.Data.(datatype.Repeated); ok {
+		b := make([]byte, a.Len())
+		if err := a.SerializeTo(b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
 	var b []byte
 	if a.VendorID > 0 {
 		b = make([]byte, 12+a.Data.Len()+a.Data.Padding())
@@ -124,6 +274,9 @@ func (a *AVP) SerializeTo(b []byte) error {
 	if a.Data == nil {
 		return errors.New("Failed to serialize AVP: Data is nil")
 	}
+	if r, ok := a.Data.(datatype.Repeated); ok {
+		return a.serializeRepeated(b, r)
+	}
 	payload := a.Data.Serialize()
 	if a.VendorID > 0 {
 		copy(b[5:8], uint32to24(uint32(12+a.Data.Len())))
@@ -138,8 +291,40 @@ func (a *AVP) SerializeTo(b []byte) error {
 	return nil
 }
 
-// Len returns the length of this AVP in bytes with padding.
+// serializeRepeated writes one AVP per value of r to b, with the code,
+// flags and vendor of a.
+func (a *AVP) serializeRepeated(b []byte, r datatype.Repeated) error {
+	values := r.Values()
+	if len(values) == 0 {
+		return fmt.Errorf("Failed to serialize AVP %d: %s has no values", a.Code, a.Data)
+	}
+	for _, v := range values {
+		item := &AVP{Code: a.Code, Flags: a.Flags, VendorID: a.VendorID, Data: v}
+		if err := item.SerializeTo(b); err != nil {
+			return err
+		}
+		b = b[item.Len():]
+	}
+	return nil
+}
+
+// wireLen returns the length of a decoded AVP in bytes with padding,
+// as it was read from the wire. It may differ from Len when the data
+// has more than one encoding, like Address.
+func (a *AVP) wireLen() int {
+	return (a.Length + 3) &^ 3
+}
+
+// Len returns the length of this AVP in bytes with padding, or of all
+// the AVPs it is serialized to when its data is datatype.Repeated.
 func (a *AVP) Len() int {
+	if r, ok := a.Data.(datatype.Repeated); ok {
+		var l int
+		for _, v := range r.Values() {
+			l += (&AVP{Flags: a.Flags, Data: v}).Len()
+		}
+		return l
+	}
 	return a.headerLen() + a.Data.Padding()
 }
 
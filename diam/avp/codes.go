@@ -8,468 +8,836 @@ package avp
 
 // Diameter AVP types.
 const (
-	ADCRuleBaseName                       = 1095
-	AFChargingIdentifier                  = 505
-	AFCorrelationInformation              = 1276
-	AccessNetworkChargingIdentifierValue  = 503
-	AccessNetworkInformation              = 1263
-	AccessTransferInformation             = 2709
-	AccessTransferType                    = 2710
-	AccountExpiration                     = 2309
-	AccountingRealtimeRequired            = 483
-	AccountingRecordNumber                = 485
-	AccountingRecordType                  = 480
-	AccountingSessionID                   = 44
-	AccountingSubSessionID                = 287
-	AcctApplicationID                     = 259
-	AcctInterimInterval                   = 85
-	AcctMultiSessionID                    = 50
-	AccumulatedCost                       = 2052
-	Adaptations                           = 1217
-	AdditionalContentInformation          = 1207
-	AdditionalTypeInformation             = 1205
-	AddressData                           = 897
-	AddressDomain                         = 898
-	AddressType                           = 899
-	AddresseeType                         = 1208
-	AllocationRetentionPriority           = 1034
-	AlternateChargedPartyAddress          = 1280
-	AoCCostInformation                    = 2053
-	AoCFormat                             = 2310
-	AoCInformation                        = 2054
-	AoCRequestType                        = 2055
-	AoCService                            = 2311
-	AoCServiceObligatoryType              = 2312
-	AoCServiceType                        = 2313
-	AoCSubscriptionInformation            = 2314
-	ApplicID                              = 1218
-	ApplicationPortIdentifer              = 3010
-	ApplicationProvidedCalledPartyAddress = 837
-	ApplicationServer                     = 836
-	ApplicationServerID                   = 2101
-	ApplicationServerInformation          = 850
-	ApplicationServiceProviderIdentity    = 532
-	ApplicationSessionID                  = 2103
-	AssociatedPartyAddress                = 2035
-	AssociatedURI                         = 856
-	AuthApplicationID                     = 258
-	AuthGracePeriod                       = 276
-	AuthRequestType                       = 274
-	AuthSessionState                      = 277
-	AuthorisedQoS                         = 849
-	AuthorizationLifetime                 = 291
-	AuxApplicInfo                         = 1219
-	BSSID                                 = 2716
-	BaseTimeInterval                      = 1265
-	BasicServiceCode                      = 3411
-	BearerCapability                      = 3412
-	BearerService                         = 854
-	CCCorrelationID                       = 411
-	CCInputOctets                         = 412
-	CCMoney                               = 413
-	CCOutputOctets                        = 414
-	CCRequestNumber                       = 415
-	CCRequestType                         = 416
-	CCServiceSpecificUnits                = 417
-	CCSessionFailover                     = 418
-	CCSubSessionID                        = 419
-	CCTime                                = 420
-	CCTotalOctets                         = 421
-	CCUnitType                            = 454
-	CGAddress                             = 846
-	CNIPMulticastDistribution             = 921
-	CNOperatorSelectionEntity             = 3421
-	CSGAccessMode                         = 2317
-	CSGID                                 = 1437
-	CSGMembershipIndication               = 2318
-	CUGInformation                        = 2304
-	CalledAssertedIdentity                = 1250
-	CalledPartyAddress                    = 832
-	CallingPartyAddress                   = 831
-	CarrierSelectRoutingInformation       = 2023
-	CauseCode                             = 861
-	ChangeCondition                       = 2037
-	ChangeTime                            = 2038
-	ChargeReasonCode                      = 2118
-	ChargedParty                          = 857
-	ChargingCharacteristicsSelectionMode  = 2066
-	ChargingRuleBaseName                  = 1004
-	CheckBalanceResult                    = 422
-	Class                                 = 25
-	ClassIdentifier                       = 1214
-	ClientAddress                         = 2018
-	ContentClass                          = 1220
-	ContentDisposition                    = 828
-	ContentID                             = 2116
-	ContentLength                         = 827
-	ContentProviderID                     = 2117
-	ContentSize                           = 1206
-	ContentType                           = 826
-	CostInformation                       = 423
-	CostUnit                              = 424
-	CreditControl                         = 426
-	CreditControlFailureHandling          = 427
-	CurrencyCode                          = 425
-	CurrentTariff                         = 2056
-	DRMContent                            = 1221
-	DataCodingScheme                      = 2001
-	DeferredLocationEventType             = 1230
-	DeliveryReportRequested               = 1216
-	DeliveryStatus                        = 2104
-	DestinationHost                       = 293
-	DestinationInterface                  = 2002
-	DestinationRealm                      = 283
-	Diagnostics                           = 2039
-	DirectDebitingFailureHandling         = 428
-	DisconnectCause                       = 273
-	DomainName                            = 1200
-	DynamicAddressFlag                    = 2051
-	DynamicAddressFlagExtension           = 2068
-	EarlyMediaDescription                 = 1272
-	Envelope                              = 1266
-	EnvelopeEndTime                       = 1267
-	EnvelopeReporting                     = 1268
-	EnvelopeStartTime                     = 1269
-	ErrorMessage                          = 281
-	ErrorReportingHost                    = 294
-	Event                                 = 825
-	EventChargingTimeStamp                = 1258
-	EventTimestamp                        = 55
-	EventType                             = 823
-	ExperimentalResult                    = 297
-	ExperimentalResultCode                = 298
-	Expires                               = 888
-	Exponent                              = 429
-	FailedAVP                             = 279
-	FileRepairSupported                   = 1224
-	FinalUnitAction                       = 449
-	FinalUnitIndication                   = 430
-	FirmwareRevision                      = 267
-	FixedUserLocationInfo                 = 2825
-	Flows                                 = 510
-	ForwardingPending                     = 3415
-	FromAddress                           = 2708
-	GGSNAddress                           = 847
-	GSUPoolIdentifier                     = 453
-	GSUPoolReference                      = 457
-	GrantedServiceUnit                    = 431
-	GuaranteedBitrateUL                   = 1026
-	HostIPAddress                         = 257
-	IMSApplicationReferenceIdentifier     = 2601
-	IMSChargingIdentifier                 = 841
-	IMSCommunicationServiceIdentifier     = 1281
-	IMSEmergencyIndicator                 = 2322
-	IMSIUnauthenticatedFlag               = 2308
-	IMSInformation                        = 876
-	IMSVisitedNetworkIdentifier           = 2713
-	IPRealmDefaultIndication              = 2603
-	ISUPCause                             = 3416
-	ISUPCauseDiagnostics                  = 3422
-	ISUPCauseLocation                     = 3423
-	ISUPCauseValue                        = 3424
-	ISUPLocationNumber                    = 3414
-	InbandSecurityID                      = 299
-	IncomingTrunkGroupID                  = 852
-	IncrementalCost                       = 2062
-	InitialIMSChargingIdentifier          = 2321
-	InstanceID                            = 3402
-	InterOperatorIdentifier               = 838
-	InterfaceID                           = 2003
-	InterfacePort                         = 2004
-	InterfaceText                         = 2005
-	InterfaceType                         = 2006
-	LCSAPN                                = 1231
-	LCSClientDialedByMS                   = 1233
-	LCSClientExternalID                   = 1234
-	LCSClientID                           = 1232
-	LCSClientName                         = 1235
-	LCSClientType                         = 1241
-	LCSDataCodingScheme                   = 1236
-	LCSFormatIndicator                    = 1237
-	LCSInformation                        = 878
-	LCSNameString                         = 1238
-	LCSRequestorID                        = 1239
-	LCSRequestorIDString                  = 1240
-	LocalGWInsertedIndication             = 2604
-	LocalSequenceNumber                   = 2063
-	LocationEstimate                      = 1242
-	LocationEstimateType                  = 1243
-	LocationType                          = 1244
-	LowBalanceIndication                  = 2020
-	LowPriorityIndicator                  = 2602
-	MBMS2G3GIndicator                     = 907
-	MBMSChargedParty                      = 2323
-	MBMSGWAddress                         = 2307
-	MBMSInformation                       = 880
-	MBMSServiceArea                       = 903
-	MBMSServiceType                       = 906
-	MBMSSessionIdentity                   = 908
-	MBMSUserServiceType                   = 1225
-	MMBoxStorageRequested                 = 1248
-	MMContentType                         = 1203
-	MMEName                               = 2402
-	MMENumberforMTSMS                     = 1645
-	MMERealm                              = 2408
-	MMSInformation                        = 877
-	MMTelInformation                      = 2030
-	MMTelSServiceType                     = 2031
-	MSCAddress                            = 3417
-	MSISDN                                = 701
-	MTCIWFAddress                         = 3406
-	MandatoryCapability                   = 604
-	MaxRequestedBandwidthDL               = 515
-	MaxRequestedBandwidthUL               = 516
-	MediaInitiatorFlag                    = 882
-	MediaInitiatorParty                   = 1288
-	MessageBody                           = 889
-	MessageClass                          = 1213
-	MessageID                             = 1210
-	MessageSize                           = 1212
-	MessageType                           = 1211
-	MultiRoundTimeOut                     = 272
-	MultipleServicesCreditControl         = 456
-	MultipleServicesIndicator             = 455
-	NNIInformation                        = 2703
-	NNIType                               = 2704
-	NeighbourNodeAddress                  = 2705
-	NetworkCallReferenceNumber            = 3418
-	NextTariff                            = 2057
-	NodeFunctionality                     = 862
-	NodeID                                = 2064
-	NumberOfDiversions                    = 2034
-	NumberOfMessagesSent                  = 2019
-	NumberOfMessagesSuccessfullyExploded  = 2111
-	NumberOfMessagesSuccessfullySent      = 2112
-	NumberOfParticipants                  = 885
-	NumberOfReceivedTalkBursts            = 1282
-	NumberOfTalkBursts                    = 1283
-	NumberPortabilityRoutingInformation   = 2024
-	OfflineCharging                       = 1278
-	OnlineChargingFlag                    = 2303
-	OptionalCapability                    = 605
-	OriginHost                            = 264
-	OriginRealm                           = 296
-	OriginStateID                         = 278
-	OriginatingIOI                        = 839
-	Originator                            = 864
-	OriginatorAddress                     = 886
-	OriginatorInterface                   = 2009
-	OriginatorReceivedAddress             = 2027
-	OriginatorSCCPAddress                 = 2008
-	OutgoingSessionID                     = 2320
-	OutgoingTrunkGroupID                  = 853
-	PDNConnectionChargingID               = 2050
-	PDPAddress                            = 1227
-	PDPAddressPrefixLength                = 2606
-	PDPContextType                        = 1247
-	PSAppendFreeFormatData                = 867
-	PSFreeFormatData                      = 866
-	PSFurnishChargingInformation          = 865
-	PSInformation                         = 874
-	ParticipantAccessPriority             = 1259
-	ParticipantActionType                 = 2049
-	ParticipantGroup                      = 1260
-	ParticipantsInvolved                  = 887
-	PoCChangeCondition                    = 1261
-	PoCChangeTime                         = 1262
-	PoCControllingAddress                 = 858
-	PoCEventType                          = 2025
-	PoCGroupName                          = 859
-	PoCInformation                        = 879
-	PoCServerRole                         = 883
-	PoCSessionID                          = 1229
-	PoCSessionInitiationtype              = 1277
-	PoCSessionType                        = 884
-	PoCUserRole                           = 1252
-	PoCUserRoleIDs                        = 1253
-	PoCUserRoleinfoUnits                  = 1254
-	PositioningData                       = 1245
-	PreferredAoCCurrency                  = 2315
-	PresenceReportingAreaIdentifier       = 2821
-	PresenceReportingAreaInformation      = 2822
-	PresenceReportingAreaStatus           = 2823
-	Priority                              = 1209
-	PriorityIndication                    = 3006
-	PriorityLevel                         = 1046
-	ProductName                           = 269
-	ProxyHost                             = 280
-	ProxyInfo                             = 284
-	ProxyState                            = 33
-	QoSClassIdentifier                    = 1028
-	QoSInformation                        = 1016
-	QuotaConsumptionTime                  = 881
-	QuotaHoldingTime                      = 871
-	RAI                                   = 909
-	RATType                               = 1032
-	RateElement                           = 2058
-	RatingGroup                           = 432
-	ReAuthRequestType                     = 285
-	ReadReplyReportRequested              = 1222
-	RealTimeTariffInformation             = 2305
-	ReasonHeader                          = 3401
-	ReceivedTalkBurstTime                 = 1284
-	ReceivedTalkBurstVolume               = 1285
-	RecipientAddress                      = 1201
-	RecipientInfo                         = 2026
-	RecipientReceivedAddress              = 2028
-	RecipientSCCPAddress                  = 2010
-	RedirectAddressType                   = 433
-	RedirectHost                          = 292
-	RedirectHostUsage                     = 261
-	RedirectMaxCacheTime                  = 262
-	RedirectServer                        = 434
-	RedirectServerAddress                 = 435
-	ReferenceNumber                       = 3007
-	RefundInformation                     = 2022
-	RelatedIMSChargingIdentifier          = 2711
-	RelatedIMSChargingIdentifierNode      = 2712
-	RelationshipMode                      = 2706
-	RemainingBalance                      = 2021
-	ReplyApplicID                         = 1223
-	ReplyPathRequested                    = 2011
-	ReportingReason                       = 872
-	RequestedAction                       = 436
-	RequestedPartyAddress                 = 1251
-	RequestedServiceUnit                  = 437
-	RequiredMBMSBearerCapabilities        = 901
-	RestrictionFilterRule                 = 438
-	ResultCode                            = 268
-	RoleOfNode                            = 829
-	RouteHeaderReceived                   = 3403
-	RouteHeaderTransmitted                = 3404
-	RouteRecord                           = 282
-	SDPAnswerTimestamp                    = 1275
-	SDPMediaComponent                     = 843
-	SDPMediaDescription                   = 845
-	SDPMediaName                          = 844
-	SDPOfferTimestamp                     = 1274
-	SDPSessionDescription                 = 842
-	SDPTimeStamps                         = 1273
-	SDPType                               = 2036
-	SGSNAddress                           = 1228
-	SGWAddress                            = 2067
-	SGWChange                             = 2065
-	SIPMethod                             = 824
-	SIPRequestTimestamp                   = 834
-	SIPRequestTimestampFraction           = 2301
-	SIPResponseTimestamp                  = 835
-	SIPResponseTimestampFraction          = 2302
-	SMDeviceTriggerIndicator              = 3407
-	SMDeviceTriggerInformation            = 3405
-	SMDischargeTime                       = 2012
-	SMMessageType                         = 2007
-	SMProtocolID                          = 2013
-	SMSCAddress                           = 2017
-	SMSInformation                        = 2000
-	SMSNode                               = 2016
-	SMSResult                             = 3409
-	SMSequenceNumber                      = 3408
-	SMServiceType                         = 2029
-	SMStatus                              = 2014
-	SMUserDataHeader                      = 2015
-	SSID                                  = 1524
-	ScaleFactor                           = 2059
-	ServedPartyIPAddress                  = 848
-	ServerCapabilities                    = 603
-	ServerName                            = 602
-	ServiceContextID                      = 461
-	ServiceDataContainer                  = 2040
-	ServiceID                             = 855
-	ServiceIdentifier                     = 439
-	ServiceInformation                    = 873
-	ServiceMode                           = 2032
-	ServiceParameterInfo                  = 440
-	ServiceParameterType                  = 441
-	ServiceParameterValue                 = 442
-	ServiceSpecificData                   = 863
-	ServiceSpecificInfo                   = 1249
-	ServiceSpecificType                   = 1257
-	ServingNode                           = 2401
-	ServingNodeType                       = 2047
-	SessionBinding                        = 270
-	SessionDirection                      = 2707
-	SessionID                             = 263
-	SessionPriority                       = 650
-	SessionServerFailover                 = 271
-	SessionTimeout                        = 27
-	SponsorIdentity                       = 531
-	StartTime                             = 2041
-	StartofCharging                       = 3419
-	StatusASCode                          = 2702
-	StopTime                              = 2042
-	SubmissionTime                        = 1202
-	SubscriberRole                        = 2033
-	SubscriptionID                        = 443
-	SubscriptionIDData                    = 444
-	SubscriptionIDType                    = 450
-	SupplementaryService                  = 2048
-	SupportedVendorID                     = 265
-	TADIdentifier                         = 2717
-	TDFIPAddress                          = 1091
-	TGPPChargingCharacteristics           = 13
-	TGPPChargingID                        = 2
-	TGPPGGSNMCCMNC                        = 9
-	TGPPIMSI                              = 1
-	TGPPIMSIMCCMNC                        = 8
-	TGPPMSTimeZone                        = 23
-	TGPPNSAPI                             = 10
-	TGPPPDPType                           = 3
-	TGPPRATType                           = 21
-	TGPPSGSNMCCMNC                        = 18
-	TGPPSelectionMode                     = 12
-	TGPPSessionStopIndicator              = 11
-	TGPPUserLocationInfo                  = 22
-	TMGI                                  = 900
-	TWANUserLocationInfo                  = 2714
-	TalkBurstExchange                     = 1255
-	TalkBurstTime                         = 1286
-	TalkBurstVolume                       = 1287
-	TariffChangeUsage                     = 452
-	TariffInformation                     = 2060
-	TariffTimeChange                      = 451
-	TariffXML                             = 2306
-	Teleservice                           = 3413
-	TerminalInformation                   = 1401
-	TerminatingIOI                        = 840
-	TerminationCause                      = 295
-	TimeFirstUsage                        = 2043
-	TimeLastUsage                         = 2044
-	TimeQuotaMechanism                    = 1270
-	TimeQuotaThreshold                    = 868
-	TimeQuotaType                         = 1271
-	TimeStamps                            = 833
-	TimeUsage                             = 2045
-	TokenText                             = 1215
-	TotalNumberOfMessagesExploded         = 2113
-	TotalNumberOfMessagesSent             = 2114
-	TrafficDataVolumes                    = 2046
-	TranscoderInsertedIndication          = 2605
-	TransitIOIList                        = 2701
-	Trigger                               = 1264
-	TriggerType                           = 870
-	TrunkGroupID                          = 851
-	TypeNumber                            = 1204
-	UnitCost                              = 2061
-	UnitQuotaThreshold                    = 1226
-	UnitValue                             = 445
-	UsedServiceUnit                       = 446
-	UserCSGInformation                    = 2319
-	UserData                              = 606
-	UserEquipmentInfo                     = 458
-	UserEquipmentInfoType                 = 459
-	UserEquipmentInfoValue                = 460
-	UserLocationInfoTime                  = 2812
-	UserName                              = 1
-	UserParticipatingType                 = 1279
-	UserSessionID                         = 830
-	VASID                                 = 1102
-	VASPID                                = 1101
-	VCSInformation                        = 3410
-	VLRNumber                             = 3420
-	ValidityTime                          = 448
-	ValueDigits                           = 447
-	VendorID                              = 266
-	VendorSpecificApplicationID           = 260
-	VolumeQuotaThreshold                  = 869
-	ePDGAddress                           = 3425
+	AAAFailureIndication                       = 1518
+	ADCRuleBaseName                            = 1095
+	AFApplicationIdentifier                    = 504
+	AFChargingIdentifier                       = 505
+	AFCorrelationInformation                   = 1276
+	AFSignallingProtocol                       = 529
+	AMBR                                       = 1435
+	AMSISDN                                    = 1643
+	ANGWAddress                                = 1050
+	ANID                                       = 1504
+	ANTrusted                                  = 1503
+	APNAggregateMaxBitrateDL                   = 1040
+	APNAggregateMaxBitrateUL                   = 1041
+	APNConfiguration                           = 1430
+	APNConfigurationProfile                    = 1429
+	APNOIReplacement                           = 1427
+	ASNumber                                   = 722
+	AUTN                                       = 1449
+	AbortCause                                 = 500
+	AcceptableServiceInfo                      = 526
+	AccessAuthorizationFlags                   = 1511
+	AccessNetworkChargingAddress               = 501
+	AccessNetworkChargingIdentifier            = 502
+	AccessNetworkChargingIdentifierGx          = 1022
+	AccessNetworkChargingIdentifierValue       = 503
+	AccessNetworkInformation                   = 1263
+	AccessRestrictionData                      = 1426
+	AccessTransferInformation                  = 2709
+	AccessTransferType                         = 2710
+	AccountExpiration                          = 2309
+	AccountingEAPAuthMethod                    = 465
+	AccountingInputOctets                      = 363
+	AccountingOutputOctets                     = 364
+	AccountingRealtimeRequired                 = 483
+	AccountingRecordNumber                     = 485
+	AccountingRecordType                       = 480
+	AccountingSessionID                        = 44
+	AccountingSubSessionID                     = 287
+	AcctApplicationID                          = 259
+	AcctInterimInterval                        = 85
+	AcctMultiSessionID                         = 50
+	AccumulatedCost                            = 2052
+	ActiveAPN                                  = 1612
+	Adaptations                                = 1217
+	AdditionalContentInformation               = 1207
+	AdditionalTypeInformation                  = 1205
+	AddressData                                = 897
+	AddressDomain                              = 898
+	AddressType                                = 899
+	AddresseeType                              = 1208
+	AgeOfLocationInformation                   = 1611
+	AlertReason                                = 1434
+	AllAPNConfigurationsIncludedIndicator      = 1428
+	AllocationRetentionPriority                = 1034
+	AlternateChargedPartyAddress               = 1280
+	AoCCostInformation                         = 2053
+	AoCFormat                                  = 2310
+	AoCInformation                             = 2054
+	AoCRequestType                             = 2055
+	AoCService                                 = 2311
+	AoCServiceObligatoryType                   = 2312
+	AoCServiceType                             = 2313
+	AoCSubscriptionInformation                 = 2314
+	ApplicID                                   = 1218
+	ApplicationDetectionInformation            = 1098
+	ApplicationPortIdentifer                   = 3010
+	ApplicationProvidedCalledPartyAddress      = 837
+	ApplicationServer                          = 836
+	ApplicationServerID                        = 2101
+	ApplicationServerInformation               = 850
+	ApplicationServiceProviderIdentity         = 532
+	ApplicationServiceType                     = 2102
+	ApplicationSessionID                       = 2103
+	AreaScope                                  = 1624
+	AssociatedIdentities                       = 632
+	AssociatedPartyAddress                     = 2035
+	AssociatedRegisteredIdentities             = 647
+	AssociatedURI                              = 856
+	AuthApplicationID                          = 258
+	AuthGracePeriod                            = 276
+	AuthRequestType                            = 274
+	AuthSessionState                           = 277
+	AuthenticationInfo                         = 1413
+	AuthorisedQoS                              = 849
+	AuthorizationLifetime                      = 291
+	AuthorizationToken                         = 506
+	AuxApplicInfo                              = 1219
+	BSSID                                      = 2716
+	BaseTimeInterval                           = 1265
+	BasicServiceCode                           = 3411
+	BearerCapability                           = 3412
+	BearerControlMode                          = 1023
+	BearerIdentifier                           = 1020
+	BearerOperation                            = 1021
+	BearerService                              = 854
+	BearerUsage                                = 1000
+	CCCorrelationID                            = 411
+	CCInputOctets                              = 412
+	CCMoney                                    = 413
+	CCOutputOctets                             = 414
+	CCRequestNumber                            = 415
+	CCRequestType                              = 416
+	CCServiceSpecificUnits                     = 417
+	CCSessionFailover                          = 418
+	CCSubSessionID                             = 419
+	CCTime                                     = 420
+	CCTotalOctets                              = 421
+	CCUnitType                                 = 454
+	CGAddress                                  = 846
+	CLRFlags                                   = 1638
+	CNIPMulticastDistribution                  = 921
+	CNOperatorSelectionEntity                  = 3421
+	CSGAccessMode                              = 2317
+	CSGID                                      = 1437
+	CSGInformationReporting                    = 1071
+	CSGMembershipIndication                    = 2318
+	CSGSubscriptionData                        = 1436
+	CUGInformation                             = 2304
+	CallBarringInfo                            = 1488
+	CallIDSIPHeader                            = 643
+	CallReferenceInfo                          = 720
+	CallReferenceNumber                        = 721
+	CalledAssertedIdentity                     = 1250
+	CalledPartyAddress                         = 832
+	CalledStationID                            = 30
+	CallingPartyAddress                        = 831
+	CallingStationID                           = 31
+	CancellationType                           = 1420
+	CarrierSelectRoutingInformation            = 2023
+	CauseCode                                  = 861
+	CellGlobalIdentity                         = 1604
+	ChangeCondition                            = 2037
+	ChangeTime                                 = 2038
+	ChargeReasonCode                           = 2118
+	ChargedParty                               = 857
+	ChargingCharacteristicsSelectionMode       = 2066
+	ChargingCorrelationIndicator               = 1073
+	ChargingInformation                        = 618
+	ChargingRuleBaseName                       = 1004
+	ChargingRuleDefinition                     = 1003
+	ChargingRuleInstall                        = 1001
+	ChargingRuleName                           = 1005
+	ChargingRuleRemove                         = 1002
+	ChargingRuleReport                         = 1018
+	CheckBalanceResult                         = 422
+	Class                                      = 25
+	ClassIdentifier                            = 1214
+	ClientAddress                              = 2018
+	ClientIdentity                             = 1480
+	CoAIPAddress                               = 1035
+	CoAInformation                             = 1039
+	CodecData                                  = 524
+	CompleteDataListIncludedIndicator          = 1468
+	ConditionalAPNAggregateMaxBitrate          = 2818
+	ConfidentialityKey                         = 625
+	Contact                                    = 641
+	ContentClass                               = 1220
+	ContentDisposition                         = 828
+	ContentID                                  = 2116
+	ContentLength                              = 827
+	ContentProviderID                          = 2117
+	ContentSize                                = 1206
+	ContentType                                = 826
+	ContextIdentifier                          = 1423
+	CostInformation                            = 423
+	CostUnit                                   = 424
+	CreditControl                              = 426
+	CreditControlFailureHandling               = 427
+	CurrencyCode                               = 425
+	CurrentLocation                            = 707
+	CurrentLocationRetrieved                   = 1610
+	CurrentTariff                              = 2056
+	DCDInformation                             = 2115
+	DEAFlags                                   = 1521
+	DERFlags                                   = 1520
+	DERS6bFlags                                = 1523
+	DRMContent                                 = 1221
+	DSAFlags                                   = 1422
+	DSAITag                                    = 711
+	DSRFlags                                   = 1421
+	DataCodingScheme                           = 2001
+	DataReference                              = 703
+	DaylightSavingTime                         = 1650
+	DefaultEPSBearerQoS                        = 1049
+	DeferredLocationEventType                  = 1230
+	DeliveryReportRequested                    = 1216
+	DeliveryStatus                             = 2104
+	DeregistrationReason                       = 615
+	DestinationHost                            = 293
+	DestinationInterface                       = 2002
+	DestinationRealm                           = 283
+	Diagnostics                                = 2039
+	DigestAlgorithm                            = 111
+	DigestHA1                                  = 121
+	DigestQoP                                  = 110
+	DigestRealm                                = 104
+	DirectDebitingFailureHandling              = 428
+	DisconnectCause                            = 273
+	DomainName                                 = 1200
+	DynamicAddressFlag                         = 2051
+	DynamicAddressFlagExtension                = 2068
+	EAPMasterSessionKey                        = 464
+	EAPPayload                                 = 462
+	EAPReissuedPayload                         = 463
+	EPSLocationInformation                     = 1496
+	EPSSubscribedQoSProfile                    = 1431
+	EPSUserState                               = 1495
+	EUTRANCellGlobalIdentity                   = 1602
+	EUTRANVector                               = 1414
+	EarlyMediaDescription                      = 1272
+	Envelope                                   = 1266
+	EnvelopeEndTime                            = 1267
+	EnvelopeReporting                          = 1268
+	EnvelopeStartTime                          = 1269
+	EquipmentStatus                            = 1445
+	EquivalentPLMNList                         = 1637
+	ErrorDiagnostic                            = 1614
+	ErrorMessage                               = 281
+	ErrorReportingHost                         = 294
+	Event                                      = 825
+	EventChargingTimeStamp                     = 1258
+	EventReportIndication                      = 1033
+	EventThresholdRSRP                         = 1629
+	EventThresholdRSRQ                         = 1630
+	EventTimestamp                             = 55
+	EventTrigger                               = 1006
+	EventType                                  = 823
+	ExperimentalResult                         = 297
+	ExperimentalResultCode                     = 298
+	ExpirationDate                             = 1439
+	Expires                                    = 888
+	ExpiryTime                                 = 709
+	Exponent                                   = 429
+	ExtPDPAddress                              = 1621
+	ExtPDPType                                 = 1620
+	ExternalClient                             = 1479
+	FailedAVP                                  = 279
+	FeatureList                                = 630
+	FeatureListID                              = 629
+	FileRepairSupported                        = 1224
+	FilterID                                   = 11
+	FinalUnitAction                            = 449
+	FinalUnitIndication                        = 430
+	FirmwareRevision                           = 267
+	FixedUserLocationInfo                      = 2825
+	FlowDescription                            = 507
+	FlowDirection                              = 1080
+	FlowInformation                            = 1058
+	FlowLabel                                  = 1057
+	FlowNumber                                 = 509
+	FlowStatus                                 = 511
+	FlowUsage                                  = 512
+	Flows                                      = 510
+	ForwardingPending                          = 3415
+	FramedIPAddress                            = 8
+	FramedIPv6Prefix                           = 97
+	FramedInterfaceID                          = 96
+	FramedMTU                                  = 12
+	FromAddress                                = 2708
+	FromSIPHeader                              = 644
+	FullNetworkName                            = 1516
+	GCSIdentifier                              = 538
+	GERANVector                                = 1416
+	GGSNAddress                                = 847
+	GMLCAddress                                = 2405
+	GMLCNumber                                 = 1474
+	GMLCRestriction                            = 1481
+	GPRSSubscriptionData                       = 1467
+	GSUPoolIdentifier                          = 453
+	GSUPoolReference                           = 457
+	GeodeticInformation                        = 1609
+	GeographicalInformation                    = 1608
+	GrantedServiceUnit                         = 431
+	GuaranteedBitrateDL                        = 1025
+	GuaranteedBitrateUL                        = 1026
+	HESSID                                     = 1525
+	HPLMNODB                                   = 1418
+	HomogeneousSupportofIMSVoiceOverPSSessions = 1493
+	HostIPAddress                              = 257
+	ICSIndicator                               = 1491
+	IDAFlags                                   = 1441
+	IDRFlags                                   = 1490
+	IMEI                                       = 1402
+	IMInformation                              = 2110
+	IMSApplicationReferenceIdentifier          = 2601
+	IMSChargingIdentifier                      = 841
+	IMSCommunicationServiceIdentifier          = 1281
+	IMSEmergencyIndicator                      = 2322
+	IMSIUnauthenticatedFlag                    = 2308
+	IMSInformation                             = 876
+	IMSVisitedNetworkIdentifier                = 2713
+	IMSVoiceOverPSSessionsSupported            = 1492
+	IPCANType                                  = 1027
+	IPDomainID                                 = 537
+	IPRealmDefaultIndication                   = 2603
+	ISUPCause                                  = 3416
+	ISUPCauseDiagnostics                       = 3422
+	ISUPCauseLocation                          = 3423
+	ISUPCauseValue                             = 3424
+	ISUPLocationNumber                         = 3414
+	IdentitySet                                = 708
+	IdentitywithEmergencyRegistration          = 651
+	ImmediateResponsePreferred                 = 1412
+	InbandSecurityID                           = 299
+	IncomingTrunkGroupID                       = 852
+	IncrementalCost                            = 2062
+	InitialCSeqSequenceNumber                  = 654
+	InitialIMSChargingIdentifier               = 2321
+	InstanceID                                 = 3402
+	IntegrityKey                               = 626
+	InterOperatorIdentifier                    = 838
+	InterfaceID                                = 2003
+	InterfacePort                              = 2004
+	InterfaceText                              = 2005
+	InterfaceType                              = 2006
+	ItemNumber                                 = 1419
+	JobType                                    = 1623
+	KASME                                      = 1450
+	Kc                                         = 1453
+	LCSAPN                                     = 1231
+	LCSCapabilitiesSets                        = 2404
+	LCSClientDialedByMS                        = 1233
+	LCSClientExternalID                        = 1234
+	LCSClientID                                = 1232
+	LCSClientName                              = 1235
+	LCSClientType                              = 1241
+	LCSDataCodingScheme                        = 1236
+	LCSFormatIndicator                         = 1237
+	LCSInfo                                    = 1473
+	LCSInformation                             = 878
+	LCSNameString                              = 1238
+	LCSPrivacyException                        = 1475
+	LCSRequestorID                             = 1239
+	LCSRequestorIDString                       = 1240
+	LIAFlags                                   = 653
+	LIPAPermission                             = 1618
+	LastUEActivityTime                         = 1494
+	ListOfMeasurements                         = 1625
+	LocalGWInsertedIndication                  = 2604
+	LocalSequenceNumber                        = 2063
+	LocalTimeZone                              = 1649
+	LocalTimeZoneIndication                    = 718
+	LocationAreaIdentity                       = 1606
+	LocationEstimate                           = 1242
+	LocationEstimateType                       = 1243
+	LocationType                               = 1244
+	LoggingDuration                            = 1632
+	LoggingInterval                            = 1631
+	LogicalAccessID                            = 302
+	LooseRouteIndication                       = 638
+	LowBalanceIndication                       = 2020
+	LowPriorityIndicator                       = 2602
+	MBMS2G3GIndicator                          = 907
+	MBMSChargedParty                           = 2323
+	MBMSGWAddress                              = 2307
+	MBMSInformation                            = 880
+	MBMSServiceArea                            = 903
+	MBMSServiceType                            = 906
+	MBMSSessionIdentity                        = 908
+	MBMSUserServiceType                        = 1225
+	MDTConfiguration                           = 1622
+	MDTUserConsent                             = 1634
+	MIP6AgentInfo                              = 486
+	MIP6FeatureVector                          = 124
+	MIP6HomeLinkPrefix                         = 125
+	MIPFARK                                    = 1506
+	MIPFARKSPI                                 = 1507
+	MIPHomeAgentAddress                        = 334
+	MIPHomeAgentHost                           = 348
+	MMBoxStorageRequested                      = 1248
+	MMContentType                              = 1203
+	MMELocationInformation                     = 1600
+	MMEName                                    = 2402
+	MMENumberforMTSMS                          = 1645
+	MMERealm                                   = 2408
+	MMEUserState                               = 1497
+	MMSInformation                             = 877
+	MMTelInformation                           = 2030
+	MMTelSServiceType                          = 2031
+	MOLR                                       = 1485
+	MPSIdentifier                              = 528
+	MPSPriority                                = 1616
+	MSCAddress                                 = 3417
+	MSCNumber                                  = 2403
+	MSISDN                                     = 701
+	MTCIWFAddress                              = 3406
+	MandatoryCapability                        = 604
+	MaxRequestedBandwidthDL                    = 515
+	MaxRequestedBandwidthUL                    = 516
+	MediaComponentDescription                  = 517
+	MediaComponentNumber                       = 518
+	MediaInitiatorFlag                         = 882
+	MediaInitiatorParty                        = 1288
+	MediaSubComponent                          = 519
+	MediaType                                  = 520
+	MessageBody                                = 889
+	MessageClass                               = 1213
+	MessageID                                  = 1210
+	MessageSize                                = 1212
+	MessageType                                = 1211
+	MeteringMethod                             = 1007
+	MinRequestedBandwidthDL                    = 534
+	MinRequestedBandwidthUL                    = 535
+	MobileNodeIdentifier                       = 506
+	MonitoringKey                              = 1066
+	MultiRoundTimeOut                          = 272
+	MultipleRegistrationIndication             = 648
+	MultipleServicesCreditControl              = 456
+	MultipleServicesIndicator                  = 455
+	NNIInformation                             = 2703
+	NNIType                                    = 2704
+	NORFlags                                   = 1443
+	NeighbourNodeAddress                       = 2705
+	NetworkAccessMode                          = 1417
+	NetworkCallReferenceNumber                 = 3418
+	NetworkRequestSupport                      = 1024
+	NextTariff                                 = 2057
+	NodeFunctionality                          = 862
+	NodeID                                     = 2064
+	Non3GPPIPAccess                            = 1501
+	Non3GPPIPAccessAPN                         = 1502
+	Non3GPPUserData                            = 1500
+	NotificationToUEUser                       = 1478
+	NumberOfDiversions                         = 2034
+	NumberOfMessagesSent                       = 2019
+	NumberOfMessagesSuccessfullyExploded       = 2111
+	NumberOfMessagesSuccessfullySent           = 2112
+	NumberOfParticipants                       = 885
+	NumberOfReceivedTalkBursts                 = 1282
+	NumberOfRequestedVectors                   = 1410
+	NumberOfTalkBursts                         = 1283
+	NumberPortabilityRoutingInformation        = 2024
+	OMCID                                      = 1466
+	Offline                                    = 1008
+	OfflineCharging                            = 1278
+	OneTimeNotification                        = 712
+	Online                                     = 1009
+	OnlineChargingFlag                         = 2303
+	OperatorDeterminedBarring                  = 1425
+	OptionalCapability                         = 605
+	OriginHost                                 = 264
+	OriginRealm                                = 296
+	OriginStateID                              = 278
+	OriginatingIOI                             = 839
+	OriginatingRequest                         = 633
+	Originator                                 = 864
+	OriginatorAddress                          = 886
+	OriginatorInterface                        = 2009
+	OriginatorReceivedAddress                  = 2027
+	OriginatorSCCPAddress                      = 2008
+	OutgoingSessionID                          = 2320
+	OutgoingTrunkGroupID                       = 853
+	PCCRuleStatus                              = 1019
+	PDNConnectionChargingID                    = 2050
+	PDNConnectionID                            = 1065
+	PDNGWAllocationType                        = 1438
+	PDNType                                    = 1456
+	PDPAddress                                 = 1227
+	PDPAddressPrefixLength                     = 2606
+	PDPContext                                 = 1469
+	PDPContextType                             = 1247
+	PDPType                                    = 1470
+	PLMNClient                                 = 1482
+	PPRFlags                                   = 1508
+	PSAppendFreeFormatData                     = 867
+	PSFreeFormatData                           = 866
+	PSFurnishChargingInformation               = 865
+	PSInformation                              = 874
+	PStoCSSessionContinuity                    = 1099
+	PUAFlags                                   = 1442
+	PURFlags                                   = 1635
+	PacketFilterContent                        = 1059
+	PacketFilterIdentifier                     = 1060
+	PacketFilterInformation                    = 1061
+	PacketFilterOperation                      = 1062
+	PacketFilterUsage                          = 1072
+	ParticipantAccessPriority                  = 1259
+	ParticipantActionType                      = 2049
+	ParticipantGroup                           = 1260
+	ParticipantsInvolved                       = 887
+	Path                                       = 640
+	PhysicalAccessID                           = 313
+	PoCChangeCondition                         = 1261
+	PoCChangeTime                              = 1262
+	PoCControllingAddress                      = 858
+	PoCEventType                               = 2025
+	PoCGroupName                               = 859
+	PoCInformation                             = 879
+	PoCServerRole                              = 883
+	PoCSessionID                               = 1229
+	PoCSessionInitiationtype                   = 1277
+	PoCSessionType                             = 884
+	PoCUserRole                                = 1252
+	PoCUserRoleIDs                             = 1253
+	PoCUserRoleinfoUnits                       = 1254
+	PositioningData                            = 1245
+	Precedence                                 = 1010
+	PreemptionCapability                       = 1047
+	PreemptionVulnerability                    = 1048
+	PreferredAoCCurrency                       = 2315
+	PrepagingSupported                         = 717
+	PresenceReportingAreaElementsList          = 2820
+	PresenceReportingAreaIdentifier            = 2821
+	PresenceReportingAreaInformation           = 2822
+	PresenceReportingAreaStatus                = 2823
+	PrimaryChargingCollectionFunctionName      = 621
+	PrimaryEventChargingFunctionName           = 619
+	Priority                                   = 1209
+	PriorityIndication                         = 3006
+	PriorityLevel                              = 1046
+	PriviledgedSenderIndication                = 652
+	ProductName                                = 269
+	ProxyHost                                  = 280
+	ProxyInfo                                  = 284
+	ProxyState                                 = 33
+	PublicIdentity                             = 601
+	QoSClassIdentifier                         = 1028
+	QoSInformation                             = 1016
+	QoSSubscribed                              = 1404
+	QuotaConsumptionTime                       = 881
+	QuotaHoldingTime                           = 871
+	RAI                                        = 909
+	RAND                                       = 1447
+	RARFlags                                   = 1522
+	RATFrequencySelectionPriorityID            = 1440
+	RATType                                    = 1032
+	RRBandwidth                                = 521
+	RSBandwidth                                = 522
+	RateElement                                = 2058
+	RatingGroup                                = 432
+	ReAuthRequestType                          = 285
+	ReSynchronizationInfo                      = 1411
+	ReadReplyReportRequested                   = 1222
+	RealTimeTariffInformation                  = 2305
+	ReasonCode                                 = 616
+	ReasonHeader                               = 3401
+	ReasonInfo                                 = 617
+	ReceivedTalkBurstTime                      = 1284
+	ReceivedTalkBurstVolume                    = 1285
+	RecipientAddress                           = 1201
+	RecipientInfo                              = 2026
+	RecipientReceivedAddress                   = 2028
+	RecipientSCCPAddress                       = 2010
+	RecordRoute                                = 646
+	RedirectAddressType                        = 433
+	RedirectHost                               = 292
+	RedirectHostUsage                          = 261
+	RedirectInformation                        = 1085
+	RedirectMaxCacheTime                       = 262
+	RedirectServer                             = 434
+	RedirectServerAddress                      = 435
+	RedirectSupport                            = 1086
+	ReferenceNumber                            = 3007
+	RefundInformation                          = 2022
+	RegionalSubscriptionZoneCode               = 1446
+	RelatedIMSChargingIdentifier               = 2711
+	RelatedIMSChargingIdentifierNode           = 2712
+	RelationshipMode                           = 2706
+	RelayNodeIndicator                         = 1633
+	RemainingBalance                           = 2021
+	ReplyApplicID                              = 1223
+	ReplyPathRequested                         = 2011
+	ReportAmount                               = 1628
+	ReportInterval                             = 1627
+	ReportingLevel                             = 1011
+	ReportingReason                            = 872
+	ReportingTrigger                           = 1626
+	RepositoryDataID                           = 715
+	RequestedAction                            = 436
+	RequestedDomain                            = 706
+	RequestedEUTRANAuthenticationInfo          = 1408
+	RequestedNodes                             = 713
+	RequestedPartyAddress                      = 1251
+	RequestedServiceUnit                       = 437
+	RequestedUTRANGERANAuthenticationInfo      = 1409
+	RequiredAccessInfo                         = 536
+	RequiredMBMSBearerCapabilities             = 901
+	ResourceAllocationNotification             = 1063
+	RestorationInfo                            = 649
+	RestrictionFilterRule                      = 438
+	ResultCode                                 = 268
+	RetryInterval                              = 541
+	RevalidationTime                           = 1042
+	RoamingRestrictedDueToUnsupportedFeature   = 1457
+	RoleOfNode                                 = 829
+	RouteHeaderReceived                        = 3403
+	RouteHeaderTransmitted                     = 3404
+	RouteRecord                                = 282
+	RoutingAreaIdentity                        = 1605
+	RuleActivationTime                         = 1043
+	RuleDeactivationTime                       = 1044
+	RuleFailureCode                            = 1031
+	RxRequestType                              = 533
+	SARFlags                                   = 655
+	SCSCFRestorationInfo                       = 639
+	SDPAnswerTimestamp                         = 1275
+	SDPMediaComponent                          = 843
+	SDPMediaDescription                        = 845
+	SDPMediaName                               = 844
+	SDPOfferTimestamp                          = 1274
+	SDPSessionDescription                      = 842
+	SDPTimeStamps                              = 1273
+	SDPType                                    = 2036
+	SGSNAddress                                = 1228
+	SGSNLocationInformation                    = 1601
+	SGSNName                                   = 2409
+	SGSNNumber                                 = 1489
+	SGSNRealm                                  = 2410
+	SGSNUserState                              = 1498
+	SGWAddress                                 = 2067
+	SGWChange                                  = 2065
+	SIPAuthDataItem                            = 612
+	SIPAuthenticate                            = 609
+	SIPAuthenticationContext                   = 611
+	SIPAuthenticationScheme                    = 608
+	SIPAuthorization                           = 610
+	SIPDigestAuthenticate                      = 635
+	SIPForkingIndication                       = 523
+	SIPItemNumber                              = 613
+	SIPMethod                                  = 824
+	SIPNumberAuthItems                         = 607
+	SIPRequestTimestamp                        = 834
+	SIPRequestTimestampFraction                = 2301
+	SIPResponseTimestamp                       = 835
+	SIPResponseTimestampFraction               = 2302
+	SIPTOPermission                            = 1613
+	SMDeviceTriggerIndicator                   = 3407
+	SMDeviceTriggerInformation                 = 3405
+	SMDischargeTime                            = 2012
+	SMMessageType                              = 2007
+	SMProtocolID                               = 2013
+	SMSCAddress                                = 2017
+	SMSInformation                             = 2000
+	SMSNode                                    = 2016
+	SMSRegisterRequest                         = 1648
+	SMSResult                                  = 3409
+	SMSequenceNumber                           = 3408
+	SMServiceType                              = 2029
+	SMStatus                                   = 2014
+	SMUserDataHeader                           = 2015
+	SRES                                       = 1454
+	SSCode                                     = 1476
+	SSID                                       = 1524
+	SSStatus                                   = 1477
+	STNSR                                      = 1433
+	ScaleFactor                                = 2059
+	SecondaryChargingCollectionFunctionName    = 622
+	SecondaryEventChargingFunctionName         = 620
+	SecurityParameterIndex                     = 1056
+	SendDataIndication                         = 710
+	SequenceNumber                             = 716
+	ServedPartyIPAddress                       = 848
+	ServerAssignmentType                       = 614
+	ServerCapabilities                         = 603
+	ServerName                                 = 602
+	ServiceAreaIdentity                        = 1607
+	ServiceContextID                           = 461
+	ServiceDataContainer                       = 2040
+	ServiceGenericInformation                  = 1256
+	ServiceID                                  = 855
+	ServiceIdentifier                          = 439
+	ServiceIndication                          = 704
+	ServiceInfoStatus                          = 527
+	ServiceInformation                         = 873
+	ServiceMode                                = 2032
+	ServiceParameterInfo                       = 440
+	ServiceParameterType                       = 441
+	ServiceParameterValue                      = 442
+	ServiceSelection                           = 493
+	ServiceSpecificData                        = 863
+	ServiceSpecificInfo                        = 1249
+	ServiceSpecificType                        = 1257
+	ServiceType                                = 1483
+	ServiceTypeIdentity                        = 1484
+	ServiceURN                                 = 525
+	ServingNode                                = 2401
+	ServingNodeIndication                      = 714
+	ServingNodeType                            = 2047
+	SessionBinding                             = 270
+	SessionDirection                           = 2707
+	SessionID                                  = 263
+	SessionPriority                            = 650
+	SessionReleaseCause                        = 1045
+	SessionServerFailover                      = 271
+	SessionTimeout                             = 27
+	ShUserData                                 = 702
+	SharingKeyDL                               = 539
+	SharingKeyUL                               = 540
+	ShortNetworkName                           = 1517
+	SoftwareVersion                            = 1403
+	SpecificAPNInfo                            = 1472
+	SpecificAction                             = 513
+	SponsorIdentity                            = 531
+	SponsoredConnectivityData                  = 530
+	StartTime                                  = 2041
+	StartofCharging                            = 3419
+	State                                      = 24
+	StatusASCode                               = 2702
+	StopTime                                   = 2042
+	SubmissionTime                             = 1202
+	SubsReqType                                = 705
+	SubscribedPeriodicRAUTAUTimer              = 1619
+	SubscribedVSRVCC                           = 1636
+	SubscriberRole                             = 2033
+	SubscriberStatus                           = 1424
+	SubscriptionData                           = 1400
+	SubscriptionID                             = 443
+	SubscriptionIDData                         = 444
+	SubscriptionIDType                         = 450
+	SubscriptionInfo                           = 642
+	SupplementaryService                       = 2048
+	SupportedApplications                      = 631
+	SupportedFeatures                          = 628
+	SupportedVendorID                          = 265
+	TADIdentifier                              = 2717
+	TDFApplicationIdentifier                   = 1088
+	TDFIPAddress                               = 1091
+	TFTFilter                                  = 1012
+	TFTPacketFilterInformation                 = 1013
+	TGPP2BSID                                  = 9010
+	TGPP2MEID                                  = 1471
+	TGPPAAAServerName                          = 318
+	TGPPChargingCharacteristics                = 13
+	TGPPChargingID                             = 2
+	TGPPGGSNMCCMNC                             = 9
+	TGPPIMSI                                   = 1
+	TGPPIMSIMCCMNC                             = 8
+	TGPPMSTimeZone                             = 23
+	TGPPNSAPI                                  = 10
+	TGPPPDPType                                = 3
+	TGPPRATType                                = 21
+	TGPPSGSNMCCMNC                             = 18
+	TGPPSelectionMode                          = 12
+	TGPPSessionStopIndicator                   = 11
+	TGPPUserLocationInfo                       = 22
+	TMGI                                       = 900
+	TSCode                                     = 1487
+	TWANAccessInfo                             = 1510
+	TWANDefaultAPNContextID                    = 1512
+	TWANUserLocationInfo                       = 2714
+	TalkBurstExchange                          = 1255
+	TalkBurstTime                              = 1286
+	TalkBurstVolume                            = 1287
+	TariffChangeUsage                          = 452
+	TariffInformation                          = 2060
+	TariffTimeChange                           = 451
+	TariffXML                                  = 2306
+	Teleservice                                = 3413
+	TeleserviceList                            = 1486
+	TerminalInformation                        = 1401
+	TerminatingIOI                             = 840
+	TerminationCause                           = 295
+	TimeFirstUsage                             = 2043
+	TimeLastUsage                              = 2044
+	TimeQuotaMechanism                         = 1270
+	TimeQuotaThreshold                         = 868
+	TimeQuotaType                              = 1271
+	TimeStamps                                 = 833
+	TimeUsage                                  = 2045
+	TimeZone                                   = 1642
+	ToSIPHeader                                = 645
+	ToSTrafficClass                            = 1014
+	TokenText                                  = 1215
+	TotalNumberOfMessagesExploded              = 2113
+	TotalNumberOfMessagesSent                  = 2114
+	TraceCollectionEntity                      = 1452
+	TraceData                                  = 1458
+	TraceDepth                                 = 1462
+	TraceEventList                             = 1465
+	TraceInfo                                  = 1505
+	TraceInterfaceList                         = 1464
+	TraceNETypeList                            = 1463
+	TraceReference                             = 1459
+	TrackingAreaIdentity                       = 1603
+	TrafficDataVolumes                         = 2046
+	TranscoderInsertedIndication               = 2605
+	TransitIOIList                             = 2701
+	TransportAccessType                        = 1519
+	Trigger                                    = 1264
+	TriggerType                                = 870
+	TrunkGroupID                               = 851
+	TunnelHeaderFilter                         = 1036
+	TunnelHeaderLength                         = 1037
+	TunnelInformation                          = 1038
+	TypeNumber                                 = 1204
+	UARFlags                                   = 637
+	UDRFlags                                   = 719
+	UESRVCCCapability                          = 1615
+	ULAFlags                                   = 1406
+	ULRFlags                                   = 1405
+	UTRANVector                                = 1415
+	UnitCost                                   = 2061
+	UnitQuotaThreshold                         = 1226
+	UnitValue                                  = 445
+	UsageMonitoringInformation                 = 1067
+	UsageMonitoringLevel                       = 1068
+	UsageMonitoringReport                      = 1069
+	UsageMonitoringSupport                     = 1070
+	UsedServiceUnit                            = 446
+	UserAuthorizationType                      = 623
+	UserCSGInformation                         = 2319
+	UserData                                   = 606
+	UserDataAlreadyAvailable                   = 624
+	UserEquipmentInfo                          = 458
+	UserEquipmentInfoType                      = 459
+	UserEquipmentInfoValue                     = 460
+	UserID                                     = 1444
+	UserIdentity                               = 700
+	UserLocationInfoTime                       = 2812
+	UserName                                   = 1
+	UserParticipatingType                      = 1279
+	UserSessionID                              = 830
+	UserState                                  = 1499
+	VASID                                      = 1102
+	VASPID                                     = 1101
+	VCSInformation                             = 3410
+	VLRNumber                                  = 3420
+	VPLMNCSGSubscriptionData                   = 1641
+	VPLMNDynamicAddressAllowed                 = 1432
+	VPLMNLIPAAllowed                           = 1617
+	ValidityTime                               = 448
+	ValueDigits                                = 447
+	VendorID                                   = 266
+	VendorSpecificApplicationID                = 260
+	VisitedNetworkIdentifier                   = 600
+	VisitedPLMNID                              = 1407
+	VolumeQuotaThreshold                       = 869
+	WLANIdentifier                             = 1509
+	WildcardedIMPU                             = 636
+	WildcardedPublicIdentity                   = 634
+	XRES                                       = 1448
+	ePDGAddress                                = 3425
 )
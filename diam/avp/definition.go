@@ -0,0 +1,57 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package avp
+
+// Definition describes an AVP of our dictionaries: its code, the vendor
+// it belongs to, and the flags it must have when sent. AVP codes are
+// only unique within a vendor, e.g. TGPPIMSI and UserName are both 1.
+type Definition struct {
+	Name     string // AVP name, as in the dictionary
+	Code     uint32
+	VendorID uint32 // Zero for IETF AVPs
+	Flags    Flags  // Flags the AVP must have
+}
+
+type vendorCode struct {
+	code   uint32
+	vendor uint32
+}
+
+var (
+	byCode = make(map[vendorCode]*Definition, len(definitions))
+	byName = make(map[string]*Definition, len(definitions))
+)
+
+func init() {
+	for i := range definitions {
+		d := &definitions[i]
+		byCode[vendorCode{d.Code, d.VendorID}] = d
+		byName[d.Name] = d
+	}
+}
+
+// Lookup returns the Definition of the AVP with the given code and
+// vendor id.
+func Lookup(code, vendorID uint32) (Definition, bool) {
+	if d, ok := byCode[vendorCode{code, vendorID}]; ok {
+		return *d, true
+	}
+	return Definition{}, false
+}
+
+// LookupName returns the Definition of the AVP with the given name,
+// e.g. "Origin-Host".
+func LookupName(name string) (Definition, bool) {
+	if d, ok := byName[name]; ok {
+		return *d, true
+	}
+	return Definition{}, false
+}
+
+// Definitions returns the definitions of all AVPs in our dictionaries,
+// sorted by name.
+func Definitions() []Definition {
+	return append([]Definition(nil), definitions...)
+}
@@ -0,0 +1,60 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package avp
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	d, ok := Lookup(UserName, 0)
+	if !ok {
+		t.Fatal("User-Name not found")
+	}
+	if d.Name != "User-Name" || d.Flags != Mbit {
+		t.Fatalf("Unexpected definition: %#v", d)
+	}
+	d, ok = Lookup(TGPPIMSI, VendorTGPP)
+	if !ok {
+		t.Fatal("TGPP-IMSI not found")
+	}
+	if d.Name != "TGPP-IMSI" || d.Flags != Vbit {
+		t.Fatalf("Unexpected definition: %#v", d)
+	}
+	if _, ok = Lookup(OriginHost, VendorTGPP); ok {
+		t.Fatal("Origin-Host found with vendor TGPP")
+	}
+}
+
+func TestLookupName(t *testing.T) {
+	d, ok := LookupName("Service-Information")
+	if !ok {
+		t.Fatal("Service-Information not found")
+	}
+	if d.Code != ServiceInformation || d.VendorID != VendorTGPP {
+		t.Fatalf("Unexpected definition: %#v", d)
+	}
+	if !d.Flags.HasVbit() {
+		t.Fatal("Service-Information must have the 'V' bit")
+	}
+	if _, ok = LookupName("No-Such-AVP"); ok {
+		t.Fatal("Unexpected definition for No-Such-AVP")
+	}
+}
+
+func TestDefinitions(t *testing.T) {
+	defs := Definitions()
+	if len(defs) != len(definitions) {
+		t.Fatalf("Unexpected # of definitions. Want %d, have %d",
+			len(definitions), len(defs))
+	}
+	for _, d := range defs {
+		if d.Flags.HasVbit() != (d.VendorID != 0) {
+			t.Fatalf("Vendor of %s does not match its flags", d.Name)
+		}
+	}
+	defs[0].Code = 0
+	if definitions[0].Code == 0 {
+		t.Fatal("Definitions returned the internal table")
+	}
+}
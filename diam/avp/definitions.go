@@ -0,0 +1,853 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// This file is auto-generated from our dictionaries.
+
+package avp
+
+// Vendor IDs.
+const (
+	VendorETSI  = 13019
+	VendorTGPP  = 10415
+	VendorTGPP2 = 5535
+)
+
+// definitions of AVPs in our dictionaries, with their vendor and the
+// flags they must have. The vendor of AVPs that must have the 'V' bit
+// is the vendor of their application, unless set by vendor-id.
+var definitions = []Definition{
+	{Name: "A-MSISDN", Code: 1643, VendorID: 10415, Flags: Vbit},
+	{Name: "AAA-Failure-Indication", Code: 1518, VendorID: 10415, Flags: Vbit},
+	{Name: "ADC-Rule-Base-Name", Code: 1095, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AF-Application-Identifier", Code: 504, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AF-Charging-Identifier", Code: 505, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AF-Correlation-Information", Code: 1276, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AF-Signalling-Protocol", Code: 529, VendorID: 10415, Flags: Vbit},
+	{Name: "AMBR", Code: 1435, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AN-GW-Address", Code: 1050, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AN-Trusted", Code: 1503, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ANID", Code: 1504, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "APN-Aggregate-Max-Bitrate-DL", Code: 1040, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "APN-Aggregate-Max-Bitrate-UL", Code: 1041, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "APN-Configuration", Code: 1430, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "APN-Configuration-Profile", Code: 1429, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "APN-OI-Replacement", Code: 1427, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AS-Number", Code: 722, VendorID: 10415, Flags: Vbit},
+	{Name: "AUTN", Code: 1449, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Abort-Cause", Code: 500, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Acceptable-Service-Info", Code: 526, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Authorization-Flags", Code: 1511, VendorID: 10415, Flags: Vbit},
+	{Name: "Access-Network-Charging-Address", Code: 501, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Network-Charging-Identifier", Code: 502, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Network-Charging-Identifier-Gx", Code: 1022, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Network-Charging-Identifier-Value", Code: 503, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Network-Information", Code: 1263, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Restriction-Data", Code: 1426, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Transfer-Information", Code: 2709, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Access-Transfer-Type", Code: 2710, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Account-Expiration", Code: 2309, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Accounting-EAP-Auth-Method", Code: 465, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Input-Octets", Code: 363, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Output-Octets", Code: 364, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Realtime-Required", Code: 483, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Record-Number", Code: 485, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Record-Type", Code: 480, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Session-Id", Code: 44, VendorID: 0, Flags: Mbit},
+	{Name: "Accounting-Sub-Session-Id", Code: 287, VendorID: 0, Flags: Mbit},
+	{Name: "Acct-Application-Id", Code: 259, VendorID: 0, Flags: Mbit},
+	{Name: "Acct-Interim-Interval", Code: 85, VendorID: 0, Flags: Mbit},
+	{Name: "Acct-Multi-Session-Id", Code: 50, VendorID: 0, Flags: Mbit},
+	{Name: "Accumulated-Cost", Code: 2052, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Active-APN", Code: 1612, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Adaptations", Code: 1217, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Additional-Content-Information", Code: 1207, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Additional-Type-Information", Code: 1205, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Address-Data", Code: 897, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Address-Domain", Code: 898, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Address-Type", Code: 899, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Addressee-Type", Code: 1208, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Age-Of-Location-Information", Code: 1611, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Alert-Reason", Code: 1434, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "All-APN-Configurations-Included-Indicator", Code: 1428, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Allocation-Retention-Priority", Code: 1034, VendorID: 10415, Flags: Vbit},
+	{Name: "Alternate-Charged-Party-Address", Code: 1280, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Cost-Information", Code: 2053, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Format", Code: 2310, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Information", Code: 2054, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Request-Type", Code: 2055, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Service", Code: 2311, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Service-Obligatory-Type", Code: 2312, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Service-Type", Code: 2313, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "AoC-Subscription-Information", Code: 2314, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Applic-Id", Code: 1218, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Detection-Information", Code: 1098, VendorID: 10415, Flags: Vbit},
+	{Name: "Application-Port-Identifer", Code: 3010, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Provided-Called-Party-Address", Code: 837, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Server", Code: 836, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Server-Id", Code: 2101, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Server-Information", Code: 850, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Service-Provider-Identity", Code: 532, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Service-Provider-Identity", Code: 532, VendorID: 10415, Flags: Vbit},
+	{Name: "Application-Service-Type", Code: 2102, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Application-Session-Id", Code: 2103, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Area-Scope", Code: 1624, VendorID: 10415, Flags: Vbit},
+	{Name: "Associated-Identities", Code: 632, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Associated-Party-Address", Code: 2035, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Associated-Registered-Identities", Code: 647, VendorID: 10415, Flags: Vbit},
+	{Name: "Associated-URI", Code: 856, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Auth-Application-Id", Code: 258, VendorID: 0, Flags: Mbit},
+	{Name: "Auth-Grace-Period", Code: 276, VendorID: 0, Flags: Mbit},
+	{Name: "Auth-Request-Type", Code: 274, VendorID: 0, Flags: Mbit},
+	{Name: "Auth-Session-State", Code: 277, VendorID: 0, Flags: Mbit},
+	{Name: "Authentication-Info", Code: 1413, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Authorised-QoS", Code: 849, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Authorization-Lifetime", Code: 291, VendorID: 0, Flags: Mbit},
+	{Name: "Authorization-Token", Code: 506, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Aux-Applic-Info", Code: 1219, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "BSSID", Code: 2716, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Base-Time-Interval", Code: 1265, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Basic-Service-Code", Code: 3411, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Bearer-Capability", Code: 3412, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Bearer-Control-Mode", Code: 1023, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Bearer-Identifier", Code: 1020, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Bearer-Operation", Code: 1021, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Bearer-Service", Code: 854, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Bearer-Usage", Code: 1000, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CC-Correlation-Id", Code: 411, VendorID: 0, Flags: 0},
+	{Name: "CC-Input-Octets", Code: 412, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Money", Code: 413, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Output-Octets", Code: 414, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Request-Number", Code: 415, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Request-Type", Code: 416, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Service-Specific-Units", Code: 417, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Session-Failover", Code: 418, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Sub-Session-Id", Code: 419, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Time", Code: 420, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Total-Octets", Code: 421, VendorID: 0, Flags: Mbit},
+	{Name: "CC-Unit-Type", Code: 454, VendorID: 0, Flags: Mbit},
+	{Name: "CG-Address", Code: 846, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CLR-Flags", Code: 1638, VendorID: 10415, Flags: Vbit},
+	{Name: "CN-IP-Multicast-Distribution", Code: 921, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CN-Operator-Selection-Entity", Code: 3421, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CSG-Access-Mode", Code: 2317, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CSG-Id", Code: 1437, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CSG-Information-Reporting", Code: 1071, VendorID: 10415, Flags: Vbit},
+	{Name: "CSG-Membership-Indication", Code: 2318, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CSG-Subscription-Data", Code: 1436, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CUG-Information", Code: 2304, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Call-Barring-Info", Code: 1488, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Call-ID-SIP-Header", Code: 643, VendorID: 10415, Flags: Vbit},
+	{Name: "Call-Reference-Info", Code: 720, VendorID: 10415, Flags: Vbit},
+	{Name: "Call-Reference-Number", Code: 721, VendorID: 10415, Flags: Vbit},
+	{Name: "Called-Asserted-Identity", Code: 1250, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Called-Party-Address", Code: 832, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Called-Station-Id", Code: 30, VendorID: 0, Flags: Mbit},
+	{Name: "Calling-Party-Address", Code: 831, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Calling-Station-Id", Code: 31, VendorID: 0, Flags: Mbit},
+	{Name: "Cancellation-Type", Code: 1420, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Carrier-Select-Routing-Information", Code: 2023, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Cause-Code", Code: 861, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Cell-Global-Identity", Code: 1604, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Change-Condition", Code: 2037, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Change-Time", Code: 2038, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charge-Reason-Code", Code: 2118, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charged-Party", Code: 857, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Characteristics-Selection-Mode", Code: 2066, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Correlation-Indicator", Code: 1073, VendorID: 10415, Flags: Vbit},
+	{Name: "Charging-Information", Code: 618, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Rule-Base-Name", Code: 1004, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Rule-Definition", Code: 1003, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Rule-Install", Code: 1001, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Rule-Name", Code: 1005, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Rule-Remove", Code: 1002, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Charging-Rule-Report", Code: 1018, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Check-Balance-Result", Code: 422, VendorID: 0, Flags: Mbit},
+	{Name: "Class", Code: 25, VendorID: 0, Flags: Mbit},
+	{Name: "Class-Identifier", Code: 1214, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Client-Address", Code: 2018, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Client-Identity", Code: 1480, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CoA-IP-Address", Code: 1035, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "CoA-Information", Code: 1039, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Codec-Data", Code: 524, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Complete-Data-List-Included-Indicator", Code: 1468, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Conditional-APN-Aggregate-Max-Bitrate", Code: 2818, VendorID: 10415, Flags: Vbit},
+	{Name: "Confidentiality-Key", Code: 625, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Contact", Code: 641, VendorID: 10415, Flags: Vbit},
+	{Name: "Content-Class", Code: 1220, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Content-Disposition", Code: 828, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Content-Id", Code: 2116, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Content-Length", Code: 827, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Content-Provider-Id", Code: 2117, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Content-Size", Code: 1206, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Content-Type", Code: 826, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Context-Identifier", Code: 1423, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Cost-Information", Code: 423, VendorID: 0, Flags: Mbit},
+	{Name: "Cost-Unit", Code: 424, VendorID: 0, Flags: Mbit},
+	{Name: "Credit-Control", Code: 426, VendorID: 0, Flags: Mbit},
+	{Name: "Credit-Control-Failure-Handling", Code: 427, VendorID: 0, Flags: Mbit},
+	{Name: "Currency-Code", Code: 425, VendorID: 0, Flags: Mbit},
+	{Name: "Current-Location", Code: 707, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Current-Location-Retrieved", Code: 1610, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Current-Tariff", Code: 2056, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "DCD-Information", Code: 2115, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "DEA-Flags", Code: 1521, VendorID: 10415, Flags: Vbit},
+	{Name: "DER-Flags", Code: 1520, VendorID: 10415, Flags: Vbit},
+	{Name: "DER-S6b-Flags", Code: 1523, VendorID: 10415, Flags: Vbit},
+	{Name: "DRM-Content", Code: 1221, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "DSA-Flags", Code: 1422, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "DSAI-Tag", Code: 711, VendorID: 10415, Flags: Vbit},
+	{Name: "DSR-Flags", Code: 1421, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Data-Coding-Scheme", Code: 2001, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Data-Reference", Code: 703, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Daylight-Saving-Time", Code: 1650, VendorID: 10415, Flags: Vbit},
+	{Name: "Default-EPS-Bearer-QoS", Code: 1049, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Deferred-Location-Event-Type", Code: 1230, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Delivery-Report-Requested", Code: 1216, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Delivery-Status", Code: 2104, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Deregistration-Reason", Code: 615, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Destination-Host", Code: 293, VendorID: 0, Flags: Mbit},
+	{Name: "Destination-Interface", Code: 2002, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Destination-Realm", Code: 283, VendorID: 0, Flags: Mbit},
+	{Name: "Diagnostics", Code: 2039, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Digest-Algorithm", Code: 111, VendorID: 0, Flags: Mbit},
+	{Name: "Digest-HA1", Code: 121, VendorID: 0, Flags: Mbit},
+	{Name: "Digest-QoP", Code: 110, VendorID: 0, Flags: Mbit},
+	{Name: "Digest-Realm", Code: 104, VendorID: 0, Flags: Mbit},
+	{Name: "Direct-Debiting-Failure-Handling", Code: 428, VendorID: 0, Flags: Mbit},
+	{Name: "Disconnect-Cause", Code: 273, VendorID: 0, Flags: Mbit},
+	{Name: "Domain-Name", Code: 1200, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Dynamic-Address-Flag", Code: 2051, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Dynamic-Address-Flag-Extension", Code: 2068, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "E-UTRAN-Cell-Global-Identity", Code: 1602, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "E-UTRAN-Vector", Code: 1414, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "EAP-Master-Session-Key", Code: 464, VendorID: 0, Flags: 0},
+	{Name: "EAP-Payload", Code: 462, VendorID: 0, Flags: Mbit},
+	{Name: "EAP-Reissued-Payload", Code: 463, VendorID: 0, Flags: Mbit},
+	{Name: "EPS-Location-Information", Code: 1496, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "EPS-Subscribed-QoS-Profile", Code: 1431, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "EPS-User-State", Code: 1495, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Early-Media-Description", Code: 1272, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Envelope", Code: 1266, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Envelope-End-Time", Code: 1267, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Envelope-Reporting", Code: 1268, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Envelope-Start-Time", Code: 1269, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Equipment-Status", Code: 1445, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Equivalent-PLMN-List", Code: 1637, VendorID: 10415, Flags: Vbit},
+	{Name: "Error-Diagnostic", Code: 1614, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Error-Message", Code: 281, VendorID: 0, Flags: 0},
+	{Name: "Error-Reporting-Host", Code: 294, VendorID: 0, Flags: 0},
+	{Name: "Event", Code: 825, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Event-Charging-TimeStamp", Code: 1258, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Event-Report-Indication", Code: 1033, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Event-Threshold-RSRP", Code: 1629, VendorID: 10415, Flags: Vbit},
+	{Name: "Event-Threshold-RSRQ", Code: 1630, VendorID: 10415, Flags: Vbit},
+	{Name: "Event-Timestamp", Code: 55, VendorID: 0, Flags: Mbit},
+	{Name: "Event-Trigger", Code: 1006, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Event-Type", Code: 823, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Experimental-Result", Code: 297, VendorID: 0, Flags: Mbit},
+	{Name: "Experimental-Result-Code", Code: 298, VendorID: 0, Flags: Mbit},
+	{Name: "Expiration-Date", Code: 1439, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Expires", Code: 888, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Expiry-Time", Code: 709, VendorID: 10415, Flags: Vbit},
+	{Name: "Exponent", Code: 429, VendorID: 0, Flags: Mbit},
+	{Name: "Ext-PDP-Address", Code: 1621, VendorID: 10415, Flags: Vbit},
+	{Name: "Ext-PDP-Type", Code: 1620, VendorID: 10415, Flags: Vbit},
+	{Name: "External-Client", Code: 1479, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Failed-AVP", Code: 279, VendorID: 0, Flags: Mbit},
+	{Name: "Feature-List", Code: 630, VendorID: 10415, Flags: Vbit},
+	{Name: "Feature-List-ID", Code: 629, VendorID: 10415, Flags: Vbit},
+	{Name: "File-Repair-Supported", Code: 1224, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Filter-Id", Code: 11, VendorID: 0, Flags: Mbit},
+	{Name: "Final-Unit-Action", Code: 449, VendorID: 0, Flags: Mbit},
+	{Name: "Final-Unit-Indication", Code: 430, VendorID: 0, Flags: Mbit},
+	{Name: "Firmware-Revision", Code: 267, VendorID: 0, Flags: 0},
+	{Name: "Fixed-User-Location-Info", Code: 2825, VendorID: 10415, Flags: Vbit},
+	{Name: "Flow-Description", Code: 507, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Flow-Direction", Code: 1080, VendorID: 10415, Flags: Vbit},
+	{Name: "Flow-Information", Code: 1058, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Flow-Label", Code: 1057, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Flow-Number", Code: 509, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Flow-Status", Code: 511, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Flow-Usage", Code: 512, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Flows", Code: 510, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Forwarding-Pending", Code: 3415, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Framed-IP-Address", Code: 8, VendorID: 0, Flags: Mbit},
+	{Name: "Framed-IPv6-Prefix", Code: 97, VendorID: 0, Flags: Mbit},
+	{Name: "Framed-Interface-Id", Code: 96, VendorID: 0, Flags: Mbit},
+	{Name: "Framed-MTU", Code: 12, VendorID: 0, Flags: Mbit},
+	{Name: "From-Address", Code: 2708, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "From-SIP-Header", Code: 644, VendorID: 10415, Flags: Vbit},
+	{Name: "Full-Network-Name", Code: 1516, VendorID: 10415, Flags: Vbit},
+	{Name: "G-S-U-Pool-Identifier", Code: 453, VendorID: 0, Flags: Mbit},
+	{Name: "G-S-U-Pool-Reference", Code: 457, VendorID: 0, Flags: Mbit},
+	{Name: "GCS-Identifier", Code: 538, VendorID: 10415, Flags: Vbit},
+	{Name: "GERAN-Vector", Code: 1416, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "GGSN-Address", Code: 847, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "GMLC-Address", Code: 2405, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "GMLC-Number", Code: 1474, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "GMLC-Restriction", Code: 1481, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "GPRS-Subscription-Data", Code: 1467, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Geodetic-Information", Code: 1609, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Geographical-Information", Code: 1608, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Granted-Service-Unit", Code: 431, VendorID: 0, Flags: Mbit},
+	{Name: "Guaranteed-Bitrate-DL", Code: 1025, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Guaranteed-Bitrate-UL", Code: 1026, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "HESSID", Code: 1525, VendorID: 10415, Flags: Vbit},
+	{Name: "HPLMN-ODB", Code: 1418, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Homogeneous-Support-of-IMS-Voice-Over-PS-Sessions", Code: 1493, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Host-IP-Address", Code: 257, VendorID: 0, Flags: Mbit},
+	{Name: "ICS-Indicator", Code: 1491, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IDA-Flags", Code: 1441, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IDR-Flags", Code: 1490, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IM-Information", Code: 2110, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMEI", Code: 1402, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Application-Reference-Identifier", Code: 2601, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Charging-Identifier", Code: 841, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Communication-Service-Identifier", Code: 1281, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Emergency-Indicator", Code: 2322, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Information", Code: 876, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Visited-Network-Identifier", Code: 2713, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMS-Voice-Over-PS-Sessions-Supported", Code: 1492, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IMSI-Unauthenticated-Flag", Code: 2308, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IP-CAN-Type", Code: 1027, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "IP-Domain-Id", Code: 537, VendorID: 10415, Flags: Vbit},
+	{Name: "IP-Realm-Default-Indication", Code: 2603, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ISUP-Cause", Code: 3416, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ISUP-Cause-Diagnostics", Code: 3422, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ISUP-Cause-Location", Code: 3423, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ISUP-Cause-Value", Code: 3424, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ISUP-Location-Number", Code: 3414, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Identity-Set", Code: 708, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Identity-with-Emergency-Registration", Code: 651, VendorID: 10415, Flags: Vbit},
+	{Name: "Immediate-Response-Preferred", Code: 1412, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Inband-Security-Id", Code: 299, VendorID: 0, Flags: Mbit},
+	{Name: "Incoming-Trunk-Group-Id", Code: 852, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Incremental-Cost", Code: 2062, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Initial-CSeq-Sequence-Number", Code: 654, VendorID: 10415, Flags: Vbit},
+	{Name: "Initial-IMS-Charging-Identifier", Code: 2321, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Instance-Id", Code: 3402, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Integrity-Key", Code: 626, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Inter-Operator-Identifier", Code: 838, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Interface-Id", Code: 2003, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Interface-Port", Code: 2004, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Interface-Text", Code: 2005, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Interface-Type", Code: 2006, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Item-Number", Code: 1419, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Job-Type", Code: 1623, VendorID: 10415, Flags: Vbit},
+	{Name: "KASME", Code: 1450, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Kc", Code: 1453, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-APN", Code: 1231, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Capabilities-Sets", Code: 2404, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Client-Dialed-By-MS", Code: 1233, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Client-External-Id", Code: 1234, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Client-Id", Code: 1232, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Client-Name", Code: 1235, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Client-Type", Code: 1241, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Data-Coding-Scheme", Code: 1236, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Format-Indicator", Code: 1237, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Info", Code: 1473, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Information", Code: 878, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Name-String", Code: 1238, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-PrivacyException", Code: 1475, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Requestor-Id", Code: 1239, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LCS-Requestor-Id-String", Code: 1240, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "LIA-Flags", Code: 653, VendorID: 10415, Flags: Vbit},
+	{Name: "LIPA-Permission", Code: 1618, VendorID: 10415, Flags: Vbit},
+	{Name: "Last-UE-Activity-Time", Code: 1494, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "List-Of-Measurements", Code: 1625, VendorID: 10415, Flags: Vbit},
+	{Name: "Local-GW-Inserted-Indication", Code: 2604, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Local-Sequence-Number", Code: 2063, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Local-Time-Zone", Code: 1649, VendorID: 10415, Flags: Vbit},
+	{Name: "Local-Time-Zone-Indication", Code: 718, VendorID: 10415, Flags: Vbit},
+	{Name: "Location-Area-Identity", Code: 1606, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Location-Estimate", Code: 1242, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Location-Estimate-Type", Code: 1243, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Location-Type", Code: 1244, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Logging-Duration", Code: 1632, VendorID: 10415, Flags: Vbit},
+	{Name: "Logging-Interval", Code: 1631, VendorID: 10415, Flags: Vbit},
+	{Name: "Logical-Access-Id", Code: 302, VendorID: 13019, Flags: Vbit},
+	{Name: "Loose-Route-Indication", Code: 638, VendorID: 10415, Flags: Vbit},
+	{Name: "Low-Balance-Indication", Code: 2020, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Low-Priority-Indicator", Code: 2602, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-2G-3G-Indicator", Code: 907, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-Charged-Party", Code: 2323, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-GW-Address", Code: 2307, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-Information", Code: 880, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-Service-Area", Code: 903, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-Service-Type", Code: 906, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-Session-Identity", Code: 908, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MBMS-User-Service-Type", Code: 1225, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MDT-Configuration", Code: 1622, VendorID: 10415, Flags: Vbit},
+	{Name: "MDT-User-Consent", Code: 1634, VendorID: 10415, Flags: Vbit},
+	{Name: "MIP-FA-RK", Code: 1506, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MIP-FA-RK-SPI", Code: 1507, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MIP-Home-Agent-Address", Code: 334, VendorID: 0, Flags: Mbit},
+	{Name: "MIP-Home-Agent-Host", Code: 348, VendorID: 0, Flags: Mbit},
+	{Name: "MIP6-Agent-Info", Code: 486, VendorID: 0, Flags: Mbit},
+	{Name: "MIP6-Feature-Vector", Code: 124, VendorID: 0, Flags: Mbit},
+	{Name: "MIP6-Home-Link-Prefix", Code: 125, VendorID: 0, Flags: Mbit},
+	{Name: "MM-Content-Type", Code: 1203, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MMBox-Storage-Requested", Code: 1248, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MME-Location-Information", Code: 1600, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MME-Name", Code: 2402, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MME-Number-for-MT-SMS", Code: 1645, VendorID: 10415, Flags: Vbit},
+	{Name: "MME-Realm", Code: 2408, VendorID: 10415, Flags: Vbit},
+	{Name: "MME-User-State", Code: 1497, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MMS-Information", Code: 877, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MMTel-Information", Code: 2030, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MMTel-SService-Type", Code: 2031, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MO-LR", Code: 1485, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MPS-Identifier", Code: 528, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MPS-Priority", Code: 1616, VendorID: 10415, Flags: Vbit},
+	{Name: "MSC-Address", Code: 3417, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MSC-Number", Code: 2403, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MSISDN", Code: 701, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "MTC-IWF-Address", Code: 3406, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Mandatory-Capability", Code: 604, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Max-Requested-Bandwidth-DL", Code: 515, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Max-Requested-Bandwidth-UL", Code: 516, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Media-Component-Description", Code: 517, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Media-Component-Number", Code: 518, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Media-Initiator-Flag", Code: 882, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Media-Initiator-Party", Code: 1288, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Media-Sub-Component", Code: 519, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Media-Type", Code: 520, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Message-Body", Code: 889, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Message-Class", Code: 1213, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Message-Id", Code: 1210, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Message-Size", Code: 1212, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Message-Type", Code: 1211, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Metering-Method", Code: 1007, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Min-Requested-Bandwidth-DL", Code: 534, VendorID: 10415, Flags: Vbit},
+	{Name: "Min-Requested-Bandwidth-UL", Code: 535, VendorID: 10415, Flags: Vbit},
+	{Name: "Mobile-Node-Identifier", Code: 506, VendorID: 0, Flags: Mbit},
+	{Name: "Monitoring-Key", Code: 1066, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Multi-Round-Time-Out", Code: 272, VendorID: 0, Flags: Mbit},
+	{Name: "Multiple-Registration-Indication", Code: 648, VendorID: 10415, Flags: Vbit},
+	{Name: "Multiple-Services-Credit-Control", Code: 456, VendorID: 0, Flags: Mbit},
+	{Name: "Multiple-Services-Indicator", Code: 455, VendorID: 0, Flags: Mbit},
+	{Name: "NNI-Information", Code: 2703, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "NNI-Type", Code: 2704, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "NOR-Flags", Code: 1443, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Neighbour-Node-Address", Code: 2705, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Network-Access-Mode", Code: 1417, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Network-Call-Reference-Number", Code: 3418, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Network-Request-Support", Code: 1024, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Next-Tariff", Code: 2057, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Node-Functionality", Code: 862, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Node-Id", Code: 2064, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Non-3GPP-IP-Access", Code: 1501, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Non-3GPP-IP-Access-APN", Code: 1502, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Non-3GPP-User-Data", Code: 1500, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Notification-To-UE-User", Code: 1478, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Diversions", Code: 2034, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Messages-Sent", Code: 2019, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Messages-Successfully-Exploded", Code: 2111, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Messages-Successfully-Sent", Code: 2112, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Participants", Code: 885, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Received-Talk-Bursts", Code: 1282, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Requested-Vectors", Code: 1410, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Of-Talk-Bursts", Code: 1283, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Number-Portability-Routing-Information", Code: 2024, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "OMC-Id", Code: 1466, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Offline", Code: 1008, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Offline-Charging", Code: 1278, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "One-Time-Notification", Code: 712, VendorID: 10415, Flags: Vbit},
+	{Name: "Online", Code: 1009, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Online-Charging-Flag", Code: 2303, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Operator-Determined-Barring", Code: 1425, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Optional-Capability", Code: 605, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Origin-Host", Code: 264, VendorID: 0, Flags: Mbit},
+	{Name: "Origin-Realm", Code: 296, VendorID: 0, Flags: Mbit},
+	{Name: "Origin-State-Id", Code: 278, VendorID: 0, Flags: Mbit},
+	{Name: "Originating-IOI", Code: 839, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Originating-Request", Code: 633, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Originator", Code: 864, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Originator-Address", Code: 886, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Originator-Interface", Code: 2009, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Originator-Received-Address", Code: 2027, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Originator-SCCP-Address", Code: 2008, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Outgoing-Session-Id", Code: 2320, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Outgoing-Trunk-Group-Id", Code: 853, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PCC-Rule-Status", Code: 1019, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDN-Connection-Charging-Id", Code: 2050, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDN-Connection-ID", Code: 1065, VendorID: 10415, Flags: Vbit},
+	{Name: "PDN-GW-Allocation-Type", Code: 1438, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDN-Type", Code: 1456, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDP-Address", Code: 1227, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDP-Address-Prefix-Length", Code: 2606, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDP-Context", Code: 1469, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDP-Context-Type", Code: 1247, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PDP-Type", Code: 1470, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PLMN-Client", Code: 1482, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PPR-Flags", Code: 1508, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PS-Append-Free-Format-Data", Code: 867, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PS-Free-Format-Data", Code: 866, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PS-Furnish-Charging-Information", Code: 865, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PS-Information", Code: 874, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PS-to-CS-Session-Continuity", Code: 1099, VendorID: 10415, Flags: Vbit},
+	{Name: "PUA-Flags", Code: 1442, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PUR-Flags", Code: 1635, VendorID: 10415, Flags: Vbit},
+	{Name: "Packet-Filter-Content", Code: 1059, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Packet-Filter-Identifier", Code: 1060, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Packet-Filter-Information", Code: 1061, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Packet-Filter-Operation", Code: 1062, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Packet-Filter-Usage", Code: 1072, VendorID: 10415, Flags: Vbit},
+	{Name: "Participant-Access-Priority", Code: 1259, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Participant-Action-Type", Code: 2049, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Participant-Group", Code: 1260, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Participants-Involved", Code: 887, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Path", Code: 640, VendorID: 10415, Flags: Vbit},
+	{Name: "Physical-Access-Id", Code: 313, VendorID: 13019, Flags: Vbit},
+	{Name: "PoC-Change-Condition", Code: 1261, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Change-Time", Code: 1262, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Controlling-Address", Code: 858, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Event-Type", Code: 2025, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Group-Name", Code: 859, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Information", Code: 879, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Server-Role", Code: 883, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Session-Id", Code: 1229, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Session-Initiation-type", Code: 1277, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-Session-Type", Code: 884, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-User-Role", Code: 1252, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-User-Role-Ids", Code: 1253, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "PoC-User-Role-info-Units", Code: 1254, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Positioning-Data", Code: 1245, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Pre-emption-Capability", Code: 1047, VendorID: 10415, Flags: Vbit},
+	{Name: "Pre-emption-Vulnerability", Code: 1048, VendorID: 10415, Flags: Vbit},
+	{Name: "Pre-paging-Supported", Code: 717, VendorID: 10415, Flags: Vbit},
+	{Name: "Precedence", Code: 1010, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Preferred-AoC-Currency", Code: 2315, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Presence-Reporting-Area-Elements-List", Code: 2820, VendorID: 10415, Flags: Vbit},
+	{Name: "Presence-Reporting-Area-Identifier", Code: 2821, VendorID: 10415, Flags: Vbit},
+	{Name: "Presence-Reporting-Area-Information", Code: 2822, VendorID: 10415, Flags: Vbit},
+	{Name: "Presence-Reporting-Area-Status", Code: 2823, VendorID: 10415, Flags: Vbit},
+	{Name: "Primary-Charging-Collection-Function-Name", Code: 621, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Primary-Event-Charging-Function-Name", Code: 619, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Priority", Code: 1209, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Priority-Indication", Code: 3006, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Priority-Level", Code: 1046, VendorID: 10415, Flags: Vbit},
+	{Name: "Priviledged-Sender-Indication", Code: 652, VendorID: 10415, Flags: Vbit},
+	{Name: "Product-Name", Code: 269, VendorID: 0, Flags: 0},
+	{Name: "Proxy-Host", Code: 280, VendorID: 0, Flags: Mbit},
+	{Name: "Proxy-Info", Code: 284, VendorID: 0, Flags: Mbit},
+	{Name: "Proxy-State", Code: 33, VendorID: 0, Flags: Mbit},
+	{Name: "Public-Identity", Code: 601, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "QoS-Class-Identifier", Code: 1028, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "QoS-Information", Code: 1016, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "QoS-Subscribed", Code: 1404, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Quota-Consumption-Time", Code: 881, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Quota-Holding-Time", Code: 871, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "RAI", Code: 909, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "RAND", Code: 1447, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "RAR-Flags", Code: 1522, VendorID: 10415, Flags: Vbit},
+	{Name: "RAT-Frequency-Selection-Priority-ID", Code: 1440, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "RAT-Type", Code: 1032, VendorID: 10415, Flags: Vbit},
+	{Name: "RR-Bandwidth", Code: 521, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "RS-Bandwidth", Code: 522, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Rate-Element", Code: 2058, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Rating-Group", Code: 432, VendorID: 0, Flags: Mbit},
+	{Name: "Re-Auth-Request-Type", Code: 285, VendorID: 0, Flags: Mbit},
+	{Name: "Re-Synchronization-Info", Code: 1411, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Read-Reply-Report-Requested", Code: 1222, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Real-Time-Tariff-Information", Code: 2305, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reason-Code", Code: 616, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reason-Header", Code: 3401, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reason-Info", Code: 617, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Received-Talk-Burst-Time", Code: 1284, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Received-Talk-Burst-Volume", Code: 1285, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Recipient-Address", Code: 1201, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Recipient-Info", Code: 2026, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Recipient-Received-Address", Code: 2028, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Recipient-SCCP-Address", Code: 2010, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Record-Route", Code: 646, VendorID: 10415, Flags: Vbit},
+	{Name: "Redirect-Address-Type", Code: 433, VendorID: 0, Flags: Mbit},
+	{Name: "Redirect-Host", Code: 292, VendorID: 0, Flags: Mbit},
+	{Name: "Redirect-Host-Usage", Code: 261, VendorID: 0, Flags: Mbit},
+	{Name: "Redirect-Information", Code: 1085, VendorID: 10415, Flags: Vbit},
+	{Name: "Redirect-Max-Cache-Time", Code: 262, VendorID: 0, Flags: Mbit},
+	{Name: "Redirect-Server", Code: 434, VendorID: 0, Flags: Mbit},
+	{Name: "Redirect-Server-Address", Code: 435, VendorID: 0, Flags: Mbit},
+	{Name: "Redirect-Support", Code: 1086, VendorID: 10415, Flags: Vbit},
+	{Name: "Reference-Number", Code: 3007, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Refund-Information", Code: 2022, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Regional-Subscription-Zone-Code", Code: 1446, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Related-IMS-Charging-Identifier", Code: 2711, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Related-IMS-Charging-Identifier-Node", Code: 2712, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Relationship-Mode", Code: 2706, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Relay-Node-Indicator", Code: 1633, VendorID: 10415, Flags: Vbit},
+	{Name: "Remaining-Balance", Code: 2021, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reply-Applic-Id", Code: 1223, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reply-Path-Requested", Code: 2011, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Report-Amount", Code: 1628, VendorID: 10415, Flags: Vbit},
+	{Name: "Report-Interval", Code: 1627, VendorID: 10415, Flags: Vbit},
+	{Name: "Reporting-Level", Code: 1011, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reporting-Reason", Code: 872, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Reporting-Trigger", Code: 1626, VendorID: 10415, Flags: Vbit},
+	{Name: "Repository-Data-ID", Code: 715, VendorID: 10415, Flags: Vbit},
+	{Name: "Requested-Action", Code: 436, VendorID: 0, Flags: Mbit},
+	{Name: "Requested-Domain", Code: 706, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Requested-EUTRAN-Authentication-Info", Code: 1408, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Requested-Nodes", Code: 713, VendorID: 10415, Flags: Vbit},
+	{Name: "Requested-Party-Address", Code: 1251, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Requested-Service-Unit", Code: 437, VendorID: 0, Flags: Mbit},
+	{Name: "Requested-UTRAN-GERAN-Authentication-Info", Code: 1409, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Required-Access-Info", Code: 536, VendorID: 10415, Flags: Vbit},
+	{Name: "Required-MBMS-Bearer-Capabilities", Code: 901, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Resource-Allocation-Notification", Code: 1063, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Restoration-Info", Code: 649, VendorID: 10415, Flags: Vbit},
+	{Name: "Restriction-Filter-Rule", Code: 438, VendorID: 0, Flags: Mbit},
+	{Name: "Result-Code", Code: 268, VendorID: 0, Flags: Mbit},
+	{Name: "Retry-Interval", Code: 541, VendorID: 10415, Flags: Vbit},
+	{Name: "Revalidation-Time", Code: 1042, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Roaming-Restricted-Due-To-Unsupported-Feature", Code: 1457, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Role-Of-Node", Code: 829, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Route-Header-Received", Code: 3403, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Route-Header-Transmitted", Code: 3404, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Route-Record", Code: 282, VendorID: 0, Flags: Mbit},
+	{Name: "Routing-Area-Identity", Code: 1605, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Rule-Activation-Time", Code: 1043, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Rule-Deactivation-Time", Code: 1044, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Rule-Failure-Code", Code: 1031, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Rx-Request-Type", Code: 533, VendorID: 10415, Flags: Vbit},
+	{Name: "SAR-Flags", Code: 655, VendorID: 10415, Flags: Vbit},
+	{Name: "SCSCF-Restoration-Info", Code: 639, VendorID: 10415, Flags: Vbit},
+	{Name: "SDP-Answer-Timestamp", Code: 1275, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-Media-Component", Code: 843, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-Media-Description", Code: 845, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-Media-Name", Code: 844, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-Offer-Timestamp", Code: 1274, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-Session-Description", Code: 842, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-TimeStamps", Code: 1273, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SDP-Type", Code: 2036, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SGSN-Address", Code: 1228, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SGSN-Location-Information", Code: 1601, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SGSN-Name", Code: 2409, VendorID: 10415, Flags: Vbit},
+	{Name: "SGSN-Number", Code: 1489, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SGSN-Realm", Code: 2410, VendorID: 10415, Flags: Vbit},
+	{Name: "SGSN-User-State", Code: 1498, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SGW-Address", Code: 2067, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SGW-Change", Code: 2065, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Auth-Data-Item", Code: 612, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Authenticate", Code: 609, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Authentication-Context", Code: 611, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Authentication-Scheme", Code: 608, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Authorization", Code: 610, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Digest-Authenticate", Code: 635, VendorID: 10415, Flags: Vbit},
+	{Name: "SIP-Forking-Indication", Code: 523, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Item-Number", Code: 613, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Method", Code: 824, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Number-Auth-Items", Code: 607, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Request-Timestamp", Code: 834, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Request-Timestamp-Fraction", Code: 2301, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Response-Timestamp", Code: 835, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIP-Response-Timestamp-Fraction", Code: 2302, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SIPTO-Permission", Code: 1613, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Device-Trigger-Indicator", Code: 3407, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Device-Trigger-Information", Code: 3405, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Discharge-Time", Code: 2012, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Message-Type", Code: 2007, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Protocol-Id", Code: 2013, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Sequence-Number", Code: 3408, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Service-Type", Code: 2029, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-Status", Code: 2014, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SM-User-Data-Header", Code: 2015, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SMS-Information", Code: 2000, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SMS-Node", Code: 2016, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SMS-Register-Request", Code: 1648, VendorID: 10415, Flags: Vbit},
+	{Name: "SMS-Result", Code: 3409, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SMSC-Address", Code: 2017, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SRES", Code: 1454, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SS-Code", Code: 1476, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SS-Status", Code: 1477, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "SSID", Code: 1524, VendorID: 10415, Flags: Vbit},
+	{Name: "STN-SR", Code: 1433, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Scale-Factor", Code: 2059, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Secondary-Charging-Collection-Function-Name", Code: 622, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Secondary-Event-Charging-Function-Name", Code: 620, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Security-Parameter-Index", Code: 1056, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Send-Data-Indication", Code: 710, VendorID: 10415, Flags: Vbit},
+	{Name: "Sequence-Number", Code: 716, VendorID: 10415, Flags: Vbit},
+	{Name: "Served-Party-IP-Address", Code: 848, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Server-Assignment-Type", Code: 614, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Server-Capabilities", Code: 603, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Server-Name", Code: 602, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Area-Identity", Code: 1607, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Context-Id", Code: 461, VendorID: 0, Flags: Mbit},
+	{Name: "Service-Data-Container", Code: 2040, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Generic-Information", Code: 1256, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Id", Code: 855, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Identifier", Code: 439, VendorID: 0, Flags: Mbit},
+	{Name: "Service-Indication", Code: 704, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Info-Status", Code: 527, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Information", Code: 873, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Mode", Code: 2032, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Parameter-Info", Code: 440, VendorID: 0, Flags: 0},
+	{Name: "Service-Parameter-Type", Code: 441, VendorID: 0, Flags: 0},
+	{Name: "Service-Parameter-Value", Code: 442, VendorID: 0, Flags: 0},
+	{Name: "Service-Selection", Code: 493, VendorID: 0, Flags: Mbit},
+	{Name: "Service-Specific-Data", Code: 863, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Specific-Info", Code: 1249, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Specific-Type", Code: 1257, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-Type", Code: 1483, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Service-URN", Code: 525, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ServiceTypeIdentity", Code: 1484, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Serving-Node", Code: 2401, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Serving-Node-Indication", Code: 714, VendorID: 10415, Flags: Vbit},
+	{Name: "Serving-Node-Type", Code: 2047, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Session-Binding", Code: 270, VendorID: 0, Flags: Mbit},
+	{Name: "Session-Direction", Code: 2707, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Session-Id", Code: 263, VendorID: 0, Flags: Mbit},
+	{Name: "Session-Priority", Code: 650, VendorID: 10415, Flags: Vbit},
+	{Name: "Session-Release-Cause", Code: 1045, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Session-Server-Failover", Code: 271, VendorID: 0, Flags: Mbit},
+	{Name: "Session-Timeout", Code: 27, VendorID: 0, Flags: Mbit},
+	{Name: "Sh-User-Data", Code: 702, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Sharing-Key-DL", Code: 539, VendorID: 10415, Flags: Vbit},
+	{Name: "Sharing-Key-UL", Code: 540, VendorID: 10415, Flags: Vbit},
+	{Name: "Short-Network-Name", Code: 1517, VendorID: 10415, Flags: Vbit},
+	{Name: "Software-Version", Code: 1403, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Specific-APN-Info", Code: 1472, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Specific-Action", Code: 513, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Sponsor-Identity", Code: 531, VendorID: 10415, Flags: Vbit},
+	{Name: "Sponsored-Connectivity-Data", Code: 530, VendorID: 10415, Flags: Vbit},
+	{Name: "Start-Time", Code: 2041, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Start-of-Charging", Code: 3419, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "State", Code: 24, VendorID: 0, Flags: Mbit},
+	{Name: "Status-AS-Code", Code: 2702, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Stop-Time", Code: 2042, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Submission-Time", Code: 1202, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Subs-Req-Type", Code: 705, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Subscribed-Periodic-RAU-TAU-Timer", Code: 1619, VendorID: 10415, Flags: Vbit},
+	{Name: "Subscribed-VSRVCC", Code: 1636, VendorID: 10415, Flags: Vbit},
+	{Name: "Subscriber-Role", Code: 2033, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Subscriber-Status", Code: 1424, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Subscription-Data", Code: 1400, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Subscription-Id", Code: 443, VendorID: 0, Flags: Mbit},
+	{Name: "Subscription-Id-Data", Code: 444, VendorID: 0, Flags: Mbit},
+	{Name: "Subscription-Id-Type", Code: 450, VendorID: 0, Flags: Mbit},
+	{Name: "Subscription-Info", Code: 642, VendorID: 10415, Flags: Vbit},
+	{Name: "Supplementary-Service", Code: 2048, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Supported-Applications", Code: 631, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Supported-Features", Code: 628, VendorID: 10415, Flags: Vbit},
+	{Name: "Supported-Vendor-Id", Code: 265, VendorID: 0, Flags: Mbit},
+	{Name: "TAD-Identifier", Code: 2717, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TDF-Application-Identifier", Code: 1088, VendorID: 10415, Flags: Vbit},
+	{Name: "TDF-IP-Address", Code: 1091, VendorID: 10415, Flags: Vbit},
+	{Name: "TFT-Filter", Code: 1012, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TFT-Packet-Filter-Information", Code: 1013, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TGPP-AAA-Server-Name", Code: 318, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TGPP-Charging-Characteristics", Code: 13, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-Charging-Id", Code: 2, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-GGSN-MCC-MNC", Code: 9, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-IMSI", Code: 1, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-IMSI-MCC-MNC", Code: 8, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-MS-TimeZone", Code: 23, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-NSAPI", Code: 10, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-PDP-Type", Code: 3, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-RAT-Type", Code: 21, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-SGSN-MCC-MNC", Code: 18, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-Selection-Mode", Code: 12, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-Session-Stop-Indicator", Code: 11, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP-User-Location-Info", Code: 22, VendorID: 10415, Flags: Vbit},
+	{Name: "TGPP2-BSID", Code: 9010, VendorID: 5535, Flags: Vbit},
+	{Name: "TGPP2-MEID", Code: 1471, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TMGI", Code: 900, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TS-Code", Code: 1487, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "TWAN-Access-Info", Code: 1510, VendorID: 10415, Flags: Vbit},
+	{Name: "TWAN-Default-APN-Context-Id", Code: 1512, VendorID: 10415, Flags: Vbit},
+	{Name: "TWAN-User-Location-Info", Code: 2714, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Talk-Burst-Exchange", Code: 1255, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Talk-Burst-Time", Code: 1286, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Talk-Burst-Volume", Code: 1287, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Tariff-Change-Usage", Code: 452, VendorID: 0, Flags: Mbit},
+	{Name: "Tariff-Information", Code: 2060, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Tariff-Time-Change", Code: 451, VendorID: 0, Flags: Mbit},
+	{Name: "Tariff-XML", Code: 2306, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Teleservice", Code: 3413, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Teleservice-List", Code: 1486, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Terminal-Information", Code: 1401, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Terminating-IOI", Code: 840, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Termination-Cause", Code: 295, VendorID: 0, Flags: Mbit},
+	{Name: "Time-First-Usage", Code: 2043, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Last-Usage", Code: 2044, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Quota-Mechanism", Code: 1270, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Quota-Threshold", Code: 868, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Quota-Type", Code: 1271, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Stamps", Code: 833, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Usage", Code: 2045, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Time-Zone", Code: 1642, VendorID: 10415, Flags: Vbit},
+	{Name: "To-SIP-Header", Code: 645, VendorID: 10415, Flags: Vbit},
+	{Name: "ToS-Traffic-Class", Code: 1014, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Token-Text", Code: 1215, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Total-Number-Of-Messages-Exploded", Code: 2113, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Total-Number-Of-Messages-Sent", Code: 2114, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Collection-Entity", Code: 1452, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Data", Code: 1458, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Depth", Code: 1462, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Event-List", Code: 1465, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Info", Code: 1505, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Interface-List", Code: 1464, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-NE-Type-List", Code: 1463, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trace-Reference", Code: 1459, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Tracking-Area-Identity", Code: 1603, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Traffic-Data-Volumes", Code: 2046, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Transcoder-Inserted-Indication", Code: 2605, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Transit-IOI-List", Code: 2701, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Transport-Access-Type", Code: 1519, VendorID: 10415, Flags: Vbit},
+	{Name: "Trigger", Code: 1264, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trigger-Type", Code: 870, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Trunk-Group-Id", Code: 851, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Tunnel-Header-Filter", Code: 1036, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Tunnel-Header-Length", Code: 1037, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Tunnel-Information", Code: 1038, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Type-Number", Code: 1204, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "UAR-Flags", Code: 637, VendorID: 10415, Flags: Vbit},
+	{Name: "UDR-Flags", Code: 719, VendorID: 10415, Flags: Vbit},
+	{Name: "UE-SRVCC-Capability", Code: 1615, VendorID: 10415, Flags: Vbit},
+	{Name: "ULA-Flags", Code: 1406, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ULR-Flags", Code: 1405, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "UTRAN-Vector", Code: 1415, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Unit-Cost", Code: 2061, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Unit-Quota-Threshold", Code: 1226, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Unit-Value", Code: 445, VendorID: 0, Flags: Mbit},
+	{Name: "Usage-Monitoring-Information", Code: 1067, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Usage-Monitoring-Level", Code: 1068, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Usage-Monitoring-Report", Code: 1069, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Usage-Monitoring-Support", Code: 1070, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Used-Service-Unit", Code: 446, VendorID: 0, Flags: Mbit},
+	{Name: "User-Authorization-Type", Code: 623, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-CSG-Information", Code: 2319, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-Data", Code: 606, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-Data-Already-Available", Code: 624, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-Equipment-Info", Code: 458, VendorID: 0, Flags: 0},
+	{Name: "User-Equipment-Info-Type", Code: 459, VendorID: 0, Flags: 0},
+	{Name: "User-Equipment-Info-Value", Code: 460, VendorID: 0, Flags: 0},
+	{Name: "User-Id", Code: 1444, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-Identity", Code: 700, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-Location-Info-Time", Code: 2812, VendorID: 10415, Flags: Vbit},
+	{Name: "User-Name", Code: 1, VendorID: 0, Flags: Mbit},
+	{Name: "User-Participating-Type", Code: 1279, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-Session-Id", Code: 830, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "User-State", Code: 1499, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VAS-Id", Code: 1102, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VASP-Id", Code: 1101, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VCS-Information", Code: 3410, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VLR-Number", Code: 3420, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VPLMN-CSG-Subscription-Data", Code: 1641, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VPLMN-Dynamic-Address-Allowed", Code: 1432, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "VPLMN-LIPA-Allowed", Code: 1617, VendorID: 10415, Flags: Vbit},
+	{Name: "Validity-Time", Code: 448, VendorID: 0, Flags: Mbit},
+	{Name: "Value-Digits", Code: 447, VendorID: 0, Flags: Mbit},
+	{Name: "Vendor-Id", Code: 266, VendorID: 0, Flags: Mbit},
+	{Name: "Vendor-Specific-Application-Id", Code: 260, VendorID: 0, Flags: Mbit},
+	{Name: "Visited-Network-Identifier", Code: 600, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Visited-PLMN-Id", Code: 1407, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "Volume-Quota-Threshold", Code: 869, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "WLAN-Identifier", Code: 1509, VendorID: 10415, Flags: Vbit},
+	{Name: "Wildcarded-IMPU", Code: 636, VendorID: 10415, Flags: Vbit},
+	{Name: "Wildcarded-Public-Identity", Code: 634, VendorID: 10415, Flags: Vbit},
+	{Name: "XRES", Code: 1448, VendorID: 10415, Flags: Mbit | Vbit},
+	{Name: "ePDG-Address", Code: 3425, VendorID: 10415, Flags: Mbit | Vbit},
+}
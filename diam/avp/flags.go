@@ -4,9 +4,69 @@
 
 package avp
 
+import "fmt"
+
 // AVP Flags. See section 4.1 of RFC 6733.
 const (
 	Pbit = 1 << 5 // The 'P' bit, reserved for future use.
 	Mbit = 1 << 6 // The 'M' bit, known as the Mandatory bit.
 	Vbit = 1 << 7 // The 'V' bit, known as the Vendor-Specific bit.
 )
+
+// Flags is the flags field of an AVP header.
+//
+// Flags can be converted from and to the Flags field of diam.AVP:
+//
+//	if avp.Flags(a.Flags).HasMbit() { ... }
+//	a.Flags = uint8(avp.Flags(a.Flags).Set(avp.Vbit))
+type Flags uint8
+
+// HasMbit reports whether the 'M' bit is set.
+func (f Flags) HasMbit() bool {
+	return f&Mbit != 0
+}
+
+// HasVbit reports whether the 'V' bit is set.
+func (f Flags) HasVbit() bool {
+	return f&Vbit != 0
+}
+
+// HasPbit reports whether the 'P' bit is set.
+func (f Flags) HasPbit() bool {
+	return f&Pbit != 0
+}
+
+// Set returns f with the given bits set.
+func (f Flags) Set(bits Flags) Flags {
+	return f | bits
+}
+
+// Clear returns f with the given bits cleared.
+func (f Flags) Clear(bits Flags) Flags {
+	return f &^ bits
+}
+
+// String returns the names of the bits set in f, separated by commas,
+// such as "M,V". Reserved bits are rendered in hexadecimal.
+func (f Flags) String() string {
+	var s []byte
+	add := func(name string) {
+		if len(s) > 0 {
+			s = append(s, ',')
+		}
+		s = append(s, name...)
+	}
+	if f.HasMbit() {
+		add("M")
+	}
+	if f.HasVbit() {
+		add("V")
+	}
+	if f.HasPbit() {
+		add("P")
+	}
+	if r := f.Clear(Mbit | Vbit | Pbit); r != 0 {
+		add(fmt.Sprintf("%#x", uint8(r)))
+	}
+	return string(s)
+}
@@ -0,0 +1,37 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package avp
+
+import "testing"
+
+func TestFlags(t *testing.T) {
+	f := Flags(Mbit)
+	if !f.HasMbit() || f.HasVbit() || f.HasPbit() {
+		t.Fatalf("Unexpected flags: %s", f)
+	}
+	f = f.Set(Vbit | Pbit)
+	if !f.HasMbit() || !f.HasVbit() || !f.HasPbit() {
+		t.Fatalf("Unexpected flags: %s", f)
+	}
+	f = f.Clear(Pbit)
+	if f != Mbit|Vbit {
+		t.Fatalf("Unexpected flags. Want 0x%x, have 0x%x", Mbit|Vbit, uint8(f))
+	}
+	testCases := []struct {
+		flags Flags
+		want  string
+	}{
+		{0, ""},
+		{Mbit, "M"},
+		{Mbit | Vbit, "M,V"},
+		{Vbit | Pbit, "V,P"},
+		{Mbit | 0x01, "M,0x1"},
+	}
+	for _, tc := range testCases {
+		if s := tc.flags.String(); s != tc.want {
+			t.Fatalf("Unexpected string for 0x%x. Want %q, have %q", uint8(tc.flags), tc.want, s)
+		}
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"encoding/hex"
 	"testing"
 
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 var testAVP = [][]byte{ // Body of a CER message
@@ -94,6 +94,35 @@ func TestDecodeAVPMalformed(t *testing.T) {
 	}
 }
 
+func TestDecodeAVPInvalidLength(t *testing.T) {
+	for _, b := range [][]byte{
+		{ // Length shorter than the AVP header
+			0x00, 0x00, 0x01, 0x0a,
+			0x40, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x0d,
+		},
+		{ // Length shorter than the AVP header with Vendor-Id
+			0x00, 0x00, 0x01, 0x0a,
+			0xc0, 0x00, 0x00, 0x0b,
+			0x00, 0x00, 0x00, 0x0d,
+		},
+		{ // Length longer than the data
+			0x00, 0x00, 0x01, 0x0a,
+			0x40, 0x00, 0x00, 0x10,
+			0x00, 0x00, 0x00, 0x0d,
+		},
+		{ // Unsigned32 data shorter than 4 bytes
+			0x00, 0x00, 0x01, 0x0a,
+			0x40, 0x00, 0x00, 0x0a,
+			0x00, 0x0d, 0x00, 0x00,
+		},
+	} {
+		if _, err := DecodeAVP(b, 0, dict.Default); err == nil {
+			t.Fatalf("Invalid AVP decoded with no error:\n%s", hex.Dump(b))
+		}
+	}
+}
+
 func TestDecodeAVPWithVendorID(t *testing.T) {
 	a := NewAVP(avp.UserName, avp.Mbit|avp.Vbit, 999, datatype.UTF8String("foobar"))
 	b, err := a.Serialize()
@@ -151,3 +180,26 @@ func BenchmarkEncodeAVP(b *testing.B) {
 		a.Serialize()
 	}
 }
+
+func TestAVPCloneEqual(t *testing.T) {
+	a := NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &GroupedAVP{
+		AVP: []*AVP{
+			NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+			NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(10415)),
+		},
+	})
+	c := a.Clone()
+	if !a.Equal(c) {
+		t.Fatalf("Unexpected difference.\nWant: %s\nHave: %s", a, c)
+	}
+	c.Data.(*GroupedAVP).AVP[1].Data = datatype.Unsigned32(13)
+	if a.Equal(c) {
+		t.Fatal("Unexpected equality after changing the clone")
+	}
+	if v := a.Data.(*GroupedAVP).AVP[1].Data; v != datatype.Unsigned32(10415) {
+		t.Fatalf("Unexpected change of the original AVP: %s", v)
+	}
+	if a.Equal(NewAVP(avp.VendorSpecificApplicationID, 0, 0, a.Data)) {
+		t.Fatal("Unexpected equality of AVPs with different flags")
+	}
+}
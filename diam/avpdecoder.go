@@ -0,0 +1,75 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+type vendorCode struct {
+	vendor uint32
+	code   uint32
+}
+
+// avpDecoderMap maps vendors and codes to the decoders registered for
+// them. Maps stored in avpDecoders are never modified: registering a
+// decoder stores a new copy, so decoding AVPs takes no lock.
+type avpDecoderMap map[vendorCode]datatype.DecoderFunc
+
+var (
+	avpDecodersMu sync.Mutex   // serializes updates to avpDecoders
+	avpDecoders   atomic.Value // avpDecoderMap
+)
+
+func init() {
+	avpDecoders.Store(avpDecoderMap{})
+}
+
+// RegisterAVPDecoder registers a decoder for the AVPs of the given
+// vendor and code, such as proprietary extensions, so they are decoded
+// into custom data types. The decoder takes precedence over the data
+// type of the AVP in the dictionary, and the AVP does not have to be
+// in the dictionary at all.
+//
+// Decoders may return a datatype.Grouped, whose AVPs are then decoded
+// using the dictionary. RegisterAVPDecoder is safe for concurrent use,
+// and meant to be called at start up: each call copies the decoders
+// registered so far.
+func RegisterAVPDecoder(vendor, code uint32, dec datatype.DecoderFunc) {
+	updateAVPDecoders(func(m avpDecoderMap) {
+		m[vendorCode{vendor, code}] = dec
+	})
+}
+
+// UnregisterAVPDecoder removes the decoder registered for the AVPs of
+// the given vendor and code, if any.
+func UnregisterAVPDecoder(vendor, code uint32) {
+	updateAVPDecoders(func(m avpDecoderMap) {
+		delete(m, vendorCode{vendor, code})
+	})
+}
+
+// updateAVPDecoders calls update with a copy of the registered
+// decoders, and stores the copy.
+func updateAVPDecoders(update func(m avpDecoderMap)) {
+	avpDecodersMu.Lock()
+	defer avpDecodersMu.Unlock()
+	prev := avpDecoders.Load().(avpDecoderMap)
+	m := make(avpDecoderMap, len(prev)+1)
+	for k, dec := range prev {
+		m[k] = dec
+	}
+	update(m)
+	avpDecoders.Store(m)
+}
+
+// findAVPDecoder returns the decoder registered for the given vendor
+// and code, if any.
+func findAVPDecoder(vendor, code uint32) datatype.DecoderFunc {
+	return avpDecoders.Load().(avpDecoderMap)[vendorCode{vendor, code}]
+}
@@ -0,0 +1,110 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// testVendorCounter is a proprietary data type: a 16-bit counter
+// followed by a name.
+type testVendorCounter struct {
+	Count uint16
+	Name  string
+}
+
+func decodeTestVendorCounter(b []byte) (datatype.Type, error) {
+	if len(b) < 2 {
+		return nil, fmt.Errorf("short counter: %d bytes", len(b))
+	}
+	return &testVendorCounter{binary.BigEndian.Uint16(b), string(b[2:])}, nil
+}
+
+func (c *testVendorCounter) Serialize() []byte {
+	b := make([]byte, 2, 2+len(c.Name))
+	binary.BigEndian.PutUint16(b, c.Count)
+	return append(b, c.Name...)
+}
+
+func (c *testVendorCounter) Len() int              { return 2 + len(c.Name) }
+func (c *testVendorCounter) Padding() int          { return 0 }
+func (c *testVendorCounter) Type() datatype.TypeID { return datatype.OctetStringType }
+func (c *testVendorCounter) String() string        { return fmt.Sprintf("%d %s", c.Count, c.Name) }
+
+func TestRegisterAVPDecoder(t *testing.T) {
+	const vendor = 99999
+	counter := &testVendorCounter{7, "hits"}
+	b, err := NewAVP(1000, avp.Mbit|avp.Vbit, vendor, counter).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewAVP(1001, avp.Mbit|avp.Vbit, vendor, datatype.OctetString("raw")).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	optional, err := NewAVP(1001, avp.Vbit, vendor, datatype.OctetString("raw")).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = DecodeAVP(b, 0, dict.Default); err == nil {
+		t.Fatal("Unexpected success decoding an unknown AVP")
+	}
+
+	RegisterAVPDecoder(vendor, 1000, decodeTestVendorCounter)
+	a, err := DecodeAVP(b, 0, dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := a.Data.(*testVendorCounter); !ok || *v != *counter {
+		t.Fatalf("Unexpected value. Want %v, have %v", counter, a.Data)
+	}
+	// Other AVPs of the vendor are unknown: they fail to decode with
+	// the M-bit, and are decoded as OctetString otherwise.
+	if _, err = DecodeAVP(other, 0, dict.Default); err == nil {
+		t.Fatal("Unexpected success decoding an unknown AVP with the M-bit")
+	}
+	a, err = DecodeAVP(optional, 0, dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Data != datatype.OctetString("raw") {
+		t.Fatalf("Unexpected value. Want OctetString{raw}, have %v", a.Data)
+	}
+
+	UnregisterAVPDecoder(vendor, 1000)
+	if _, err = DecodeAVP(b, 0, dict.Default); err == nil {
+		t.Fatal("Unexpected success decoding an unknown AVP after unregistering")
+	}
+}
+
+func TestRegisterAVPDecoderConcurrent(t *testing.T) {
+	const vendor = 99998
+	b, err := NewAVP(1000, avp.Mbit|avp.Vbit, vendor, &testVendorCounter{1, "a"}).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	RegisterAVPDecoder(vendor, 1000, decodeTestVendorCounter)
+	defer UnregisterAVPDecoder(vendor, 1000)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for code := uint32(2000); code < 2100; code++ {
+			RegisterAVPDecoder(vendor, code, decodeTestVendorCounter)
+			UnregisterAVPDecoder(vendor, code)
+		}
+	}()
+	for n := 0; n < 100; n++ {
+		if _, err = DecodeAVP(b, 0, dict.Default); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-done
+}
@@ -0,0 +1,177 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+// Blacklist temporarily blocks peers, by IP address, after repeated
+// failures such as failed CER/CEA handshakes or messages that cannot
+// be decoded. Connections from blocked peers are closed right after
+// being accepted, like the ones rejected by Server.DenyNet.
+//
+// A Blacklist is enabled by setting Server.Blacklist, which records
+// failures to read messages, and may be shared with the state machine,
+// which records failed handshakes. See sm.Settings.Blacklist.
+//
+// The methods of Blacklist are safe for concurrent use, and can be
+// called on a nil Blacklist, which blocks nothing.
+type Blacklist struct {
+	// Threshold is the number of failures after which a peer is
+	// blocked. Failures are counted until the peer is blocked, Reset
+	// is called, typically after a successful handshake, or Window
+	// has passed since the first one.
+	Threshold int
+
+	// Duration is how long peers remain blocked.
+	Duration time.Duration
+
+	// Window is how long failures are counted, from the first one of
+	// a peer. It defaults to Duration.
+	Window time.Duration
+
+	mu      sync.Mutex
+	fails   map[string]failures
+	blocked map[string]time.Time // Expiry by IP
+	sweep   time.Time            // Time of the next sweep of expired entries
+}
+
+// failures are the failures of a peer within the window.
+type failures struct {
+	n      int
+	expiry time.Time
+}
+
+// NewBlacklist returns a Blacklist that blocks peers for the given
+// duration after threshold failures.
+func NewBlacklist(threshold int, duration time.Duration) *Blacklist {
+	return &Blacklist{Threshold: threshold, Duration: duration}
+}
+
+// Fail records a failure of the peer at addr for the reason err, and
+// reports whether the peer got blocked because of it.
+func (b *Blacklist) Fail(addr net.Addr, err error) bool {
+	ip := addrIP(addr)
+	if b == nil || ip == nil {
+		return false
+	}
+	k := ip.String()
+	now := time.Now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.fails == nil {
+		b.fails = make(map[string]failures)
+		b.blocked = make(map[string]time.Time)
+	}
+	b.sweepExpired(now)
+	f, ok := b.fails[k]
+	if !ok || now.After(f.expiry) {
+		f = failures{expiry: now.Add(b.window())}
+	}
+	f.n++
+	if f.n < b.Threshold {
+		b.fails[k] = f
+		return false
+	}
+	delete(b.fails, k)
+	b.blocked[k] = now.Add(b.Duration)
+	log.Printf("diam: blocking %s for %s after %d failures, last: %v",
+		k, b.Duration, b.Threshold, err)
+	return true
+}
+
+// window returns the duration of the window in which failures are
+// counted.
+func (b *Blacklist) window() time.Duration {
+	if b.Window > 0 {
+		return b.Window
+	}
+	return b.Duration
+}
+
+// sweepExpired removes the expired failures and blocks, so that peers
+// that fail once or are blocked and never come back are forgotten. It
+// runs at most once per window, and must be called with mu held.
+func (b *Blacklist) sweepExpired(now time.Time) {
+	if now.Before(b.sweep) {
+		return
+	}
+	b.sweep = now.Add(b.window())
+	for k, f := range b.fails {
+		if now.After(f.expiry) {
+			delete(b.fails, k)
+		}
+	}
+	for k, expiry := range b.blocked {
+		if now.After(expiry) {
+			delete(b.blocked, k)
+		}
+	}
+}
+
+// Reset clears the failures recorded for the peer at addr.
+func (b *Blacklist) Reset(addr net.Addr) {
+	ip := addrIP(addr)
+	if b == nil || ip == nil {
+		return
+	}
+	b.mu.Lock()
+	delete(b.fails, ip.String())
+	b.mu.Unlock()
+}
+
+// Blocked reports whether the peer at addr is blocked.
+func (b *Blacklist) Blocked(addr net.Addr) bool {
+	ip := addrIP(addr)
+	if b == nil || ip == nil {
+		return false
+	}
+	k := ip.String()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	expiry, ok := b.blocked[k]
+	if ok && time.Now().After(expiry) {
+		delete(b.blocked, k)
+		return false
+	}
+	return ok
+}
+
+// Unblock removes the IP address ip from the blacklist, along with
+// its recorded failures.
+func (b *Blacklist) Unblock(ip net.IP) {
+	if b == nil {
+		return
+	}
+	k := ip.String()
+	b.mu.Lock()
+	delete(b.blocked, k)
+	delete(b.fails, k)
+	b.mu.Unlock()
+}
+
+// List returns the IP addresses currently blocked, and when they are
+// unblocked.
+func (b *Blacklist) List() map[string]time.Time {
+	list := make(map[string]time.Time)
+	if b == nil {
+		return list
+	}
+	now := time.Now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for k, expiry := range b.blocked {
+		if now.After(expiry) {
+			delete(b.blocked, k)
+			continue
+		}
+		list[k] = expiry
+	}
+	return list
+}
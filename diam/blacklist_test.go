@@ -0,0 +1,111 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam_test
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+)
+
+func TestBlacklist(t *testing.T) {
+	b := diam.NewBlacklist(2, 50*time.Millisecond)
+	peer := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 3868}
+	other := &net.TCPAddr{IP: net.ParseIP("192.0.2.2"), Port: 3868}
+	reason := errors.New("test")
+	if b.Fail(peer, reason) || b.Blocked(peer) {
+		t.Fatal("Peer blocked before reaching the threshold")
+	}
+	b.Reset(peer)
+	if b.Fail(peer, reason) || b.Blocked(peer) {
+		t.Fatal("Peer blocked after reset")
+	}
+	if !b.Fail(peer, reason) || !b.Blocked(peer) || b.Blocked(other) {
+		t.Fatal("Peer not blocked after reaching the threshold")
+	}
+	if l := b.List(); len(l) != 1 || l["192.0.2.1"].IsZero() {
+		t.Fatalf("Unexpected blacklist: %v", l)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if b.Blocked(peer) || len(b.List()) != 0 {
+		t.Fatal("Peer still blocked after expiry")
+	}
+	b.Fail(peer, reason)
+	b.Fail(peer, reason)
+	b.Unblock(peer.IP)
+	if b.Blocked(peer) {
+		t.Fatal("Peer still blocked after unblock")
+	}
+	var nb *diam.Blacklist
+	if nb.Fail(peer, reason) || nb.Blocked(peer) || len(nb.List()) != 0 {
+		t.Fatal("Nil blacklist blocked a peer")
+	}
+}
+
+func TestBlacklistWindow(t *testing.T) {
+	b := diam.NewBlacklist(2, time.Minute)
+	b.Window = 30 * time.Millisecond
+	peer := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 3868}
+	reason := errors.New("test")
+	b.Fail(peer, reason)
+	time.Sleep(40 * time.Millisecond)
+	if b.Fail(peer, reason) || b.Blocked(peer) {
+		t.Fatal("Peer blocked after failures expired")
+	}
+	if !b.Fail(peer, reason) || !b.Blocked(peer) {
+		t.Fatal("Peer not blocked after reaching the threshold within the window")
+	}
+}
+
+func TestServer_Blacklist(t *testing.T) {
+	srv := diamtest.NewUnstartedServer(diam.NewServeMux(), nil)
+	srv.Config.Blacklist = diam.NewBlacklist(2, time.Minute)
+	srv.Start()
+	defer srv.Close()
+	// Header of an unknown command, which closes the connection.
+	bad := []byte{
+		0x01, 0x00, 0x00, 0x14, 0x80, 0x0f, 0x42, 0x3f, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01,
+	}
+	for n := 0; n < 2; n++ {
+		c, err := net.Dial("tcp", srv.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Write(bad)
+		c.SetReadDeadline(time.Now().Add(time.Second))
+		if _, err = c.Read(make([]byte, 1)); err == nil {
+			t.Fatal("Connection was not closed by the server")
+		}
+		c.Close()
+	}
+	for n := 0; len(srv.Config.Blacklist.List()) == 0; n++ {
+		if n == 100 {
+			t.Fatal("Peer was not blocked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c, err := net.Dial("tcp", srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err = c.Read(make([]byte, 1)); err == nil {
+		t.Fatal("Connection was not closed by the server")
+	}
+	if n := srv.Config.Rejected(); n != 1 {
+		t.Fatalf("Unexpected # of rejected connections. Want 1, have %d", n)
+	}
+	srv.Config.Blacklist.Unblock(net.ParseIP("127.0.0.1"))
+	srv.Config.Blacklist.Unblock(net.ParseIP("::1"))
+	if l := srv.Config.Blacklist.List(); len(l) != 0 {
+		t.Fatalf("Unexpected blacklist after unblock: %v", l)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// MessageBuilder builds a message by chaining calls, and checks it
+// when Build is called:
+//
+//	m, err := diam.NewMessageBuilder(diam.CapabilitiesExchange, 0, dict.Default).
+//		Add("Origin-Host", "client").
+//		Add("Origin-Realm", "localhost").
+//		Add("Host-IP-Address", "10.1.0.1").
+//		Add("Vendor-Id", 13).
+//		AVP(avp.ProductName, 0, 0, datatype.UTF8String("go-diameter")).
+//		Build()
+//
+// The first error found while adding AVPs is returned by Build, so
+// there is no need to check each call. Unlike Message.NewAVP, adding
+// AVPs does not update the length of the message, which Build sets
+// once all AVPs are added.
+type MessageBuilder struct {
+	m   *Message
+	err error
+}
+
+// NewMessageBuilder returns a MessageBuilder for a request of the
+// command cmd of the application appid.
+func NewMessageBuilder(cmd, appid uint32, dictionary *dict.Parser) *MessageBuilder {
+	return &MessageBuilder{m: NewMessage(cmd, RequestFlag, appid, 0, 0, dictionary)}
+}
+
+// Flags sets the command flags of the message, in addition to the
+// R-bit of requests.
+func (b *MessageBuilder) Flags(flags uint8) *MessageBuilder {
+	b.m.Header.CommandFlags |= flags
+	return b
+}
+
+// Answer turns the message into an answer, clearing its R-bit.
+func (b *MessageBuilder) Answer() *MessageBuilder {
+	b.m.Header.CommandFlags &^= RequestFlag
+	return b
+}
+
+// IDs sets the Hop-by-Hop and End-to-End identifiers of the message,
+// which are otherwise generated.
+func (b *MessageBuilder) IDs(hopbyhop, endtoend uint32) *MessageBuilder {
+	b.m.Header.HopByHopID = hopbyhop
+	b.m.Header.EndToEndID = endtoend
+	return b
+}
+
+// AVP adds an AVP to the message, as Message.NewAVP does.
+func (b *MessageBuilder) AVP(code interface{}, flags uint8, vendor uint32, data datatype.Type) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	if r, ok := data.(datatype.Repeated); ok {
+		if len(r.Values()) == 0 {
+			b.err = fmt.Errorf("No values in %s", r)
+			return b
+		}
+		for _, v := range r.Values() {
+			b.AVP(code, flags, vendor, v)
+		}
+		return b
+	}
+	switch c := code.(type) {
+	case int:
+		b.m.AVP = append(b.m.AVP, NewAVP(uint32(c), flags, vendor, data))
+	case uint32:
+		b.m.AVP = append(b.m.AVP, NewAVP(c, flags, vendor, data))
+	case string:
+		d, err := b.m.Dictionary().FindAVP(b.m.Header.ApplicationID, c)
+		if err != nil {
+			b.err = err
+			return b
+		}
+		b.m.AVP = append(b.m.AVP, NewAVP(d.Code, flags, vendor, data))
+	default:
+		b.err = fmt.Errorf("Unsupported AVP code type %#v", code)
+	}
+	return b
+}
+
+// Add adds the AVP name with the value v, converted to the data type
+// of the AVP and with the flags and vendor of the dictionary, as
+// Marshal does. Maps are added as Grouped AVPs, as in BuildAVPs, and
+// slices as one AVP per item.
+func (b *MessageBuilder) Add(name string, v interface{}) *MessageBuilder {
+	if b.err != nil {
+		return b
+	}
+	d, err := b.m.Dictionary().FindAVP(b.m.Header.ApplicationID, name)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	avps, err := marshal(b.m, d, reflect.ValueOf(v))
+	if err != nil {
+		b.err = fmt.Errorf("%s: %s", name, err)
+		return b
+	}
+	b.m.AVP = append(b.m.AVP, avps...)
+	return b
+}
+
+// AddAVP adds the AVP a to the message.
+func (b *MessageBuilder) AddAVP(a *AVP) *MessageBuilder {
+	b.m.AVP = append(b.m.AVP, a)
+	return b
+}
+
+// Build returns the message, with its length set, after checking it
+// against the dictionary with Message.Validate. It returns the first
+// error found while adding AVPs, or the *ResultError of Validate.
+func (b *MessageBuilder) Build() (*Message, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	b.m.Header.MessageLength = uint32(b.m.Len())
+	if err := b.m.Validate(nil); err != nil {
+		return nil, err
+	}
+	return b.m, nil
+}
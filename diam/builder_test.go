@@ -0,0 +1,76 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestMessageBuilder(t *testing.T) {
+	want, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMessageBuilder(CapabilitiesExchange, 0, dict.Default).
+		IDs(want.Header.HopByHopID, want.Header.EndToEndID).
+		Add("Origin-Host", "test").
+		Add("Origin-Realm", "localhost").
+		Add("Host-IP-Address", "10.1.0.1").
+		Add("Vendor-Id", 13).
+		AVP("Product-Name", 0, 0, datatype.UTF8String("go-diameter")).
+		Add("Origin-State-Id", 1397760650).
+		AVP(avp.SupportedVendorID, avp.Mbit, 0, datatype.Unsigned32List{
+			10415,
+			13,
+		}).
+		AddAVP(want.AVP[8]).
+		Add("Inband-Security-Id", 0).
+		Add("Vendor-Specific-Application-Id", map[string]interface{}{
+			"Auth-Application-Id": 4,
+			"Vendor-Id":           10415,
+		}).
+		Add("Firmware-Revision", 1).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Header.MessageLength != uint32(len(testMessage)) {
+		t.Fatalf("Unexpected length. Want %d, have %d", len(testMessage), m.Header.MessageLength)
+	}
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, testMessage) {
+		t.Fatalf("Unexpected message.\nWant:\n%s\nHave:\n%s", want, m)
+	}
+}
+
+func TestMessageBuilderError(t *testing.T) {
+	_, err := NewMessageBuilder(CapabilitiesExchange, 0, dict.Default).
+		Add("No-Such-AVP", 1).
+		Add("Origin-Host", "test").
+		Build()
+	if err == nil {
+		t.Fatal("Built message with unknown AVP")
+	}
+	_, err = NewMessageBuilder(CapabilitiesExchange, 0, dict.Default).
+		Add("Origin-Host", "test").
+		Build()
+	if e, ok := err.(*ResultError); !ok || e.Code != MissingAVP {
+		t.Fatalf("Unexpected error. Want missing AVP, have %v", err)
+	}
+	_, err = NewMessageBuilder(CapabilitiesExchange, 0, dict.Default).
+		AVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32List{}).
+		Build()
+	if err == nil {
+		t.Fatal("Built message with an empty list")
+	}
+}
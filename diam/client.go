@@ -10,13 +10,13 @@ import (
 	"crypto/tls"
 	"net"
 
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // Dial connects to the peer pointed to by addr and returns the Conn that
 // can be used to send diameter messages. Incoming messages are handled
 // by the handler, which is tipically nil and DefaultServeMux is used.
-// If dict is nil, dict.Default is used.
+// If dict is nil, dict.DefaultParser is used.
 func Dial(addr string, handler Handler, dp *dict.Parser) (Conn, error) {
 	srv := &Server{Addr: addr, Handler: handler, Dict: dp}
 	return dial(srv)
@@ -40,6 +40,10 @@ func dial(srv *Server) (Conn, error) {
 }
 
 // DialTLS is the same as Dial, but for TLS.
+//
+// TLS sessions are resumed when reconnecting to the same address. The
+// session cache is shared by all connections unless the TLSConfig of
+// the connection provides a ClientSessionCache.
 func DialTLS(addr, certFile, keyFile string, handler Handler, dp *dict.Parser) (Conn, error) {
 	srv := &Server{Addr: addr, Handler: handler, Dict: dp}
 	return dialTLS(srv, certFile, keyFile)
@@ -54,6 +58,9 @@ func dialTLS(srv *Server, certFile, keyFile string) (Conn, error) {
 	if srv.TLSConfig != nil {
 		*config = *srv.TLSConfig
 	}
+	if config.ClientSessionCache == nil {
+		config.ClientSessionCache = clientSessionCache
+	}
 	if len(certFile) != 0 {
 		var err error
 		config.Certificates = make([]tls.Certificate, 1)
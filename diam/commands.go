@@ -8,12 +8,32 @@ package diam
 
 // Diameter command codes.
 const (
-	AbortSession         = 274
-	Accounting           = 271
-	CapabilitiesExchange = 257
-	CreditControl        = 272
-	DeviceWatchdog       = 280
-	DisconnectPeer       = 282
-	ReAuth               = 258
-	SessionTermination   = 275
+	AA                        = 265
+	AbortSession              = 274
+	Accounting                = 271
+	AuthenticationInformation = 318
+	CancelLocation            = 317
+	CapabilitiesExchange      = 257
+	CreditControl             = 272
+	DeleteSubscriberData      = 320
+	DeviceWatchdog            = 280
+	DiameterEAP               = 268
+	DisconnectPeer            = 282
+	InsertSubscriberData      = 319
+	LocationInfo              = 302
+	MultimediaAuth            = 303
+	Notify                    = 323
+	ProfileUpdate             = 307
+	PurgeUE                   = 321
+	PushNotification          = 309
+	PushProfile               = 305
+	ReAuth                    = 258
+	RegistrationTermination   = 304
+	Reset                     = 322
+	ServerAssignment          = 301
+	SessionTermination        = 275
+	SubscribeNotifications    = 308
+	UpdateLocation            = 316
+	UserAuthorization         = 300
+	UserData                  = 306
 )
@@ -12,8 +12,24 @@ import (
 )
 
 // Address data type.
+//
+// IPv4 addresses, including IPv4 addresses in the 16-byte form returned
+// by net.ParseIP, are encoded with the IPv4 address family (1). Any
+// other address is encoded with the IPv6 address family (2).
 type Address net.IP
 
+// NewAddress returns ip as an Address, or an error if ip is not a valid
+// IPv4 or IPv6 address. IPv4 addresses are stored in their 4-byte form.
+func NewAddress(ip net.IP) (Address, error) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return Address(ip4), nil
+	}
+	if len(ip) != net.IPv6len {
+		return nil, fmt.Errorf("Invalid IP address: %v", ip)
+	}
+	return Address(ip), nil
+}
+
 // DecodeAddress decodes an Address data type from byte array.
 func DecodeAddress(b []byte) (Type, error) {
 	if len(b) < 6 {
@@ -31,7 +47,10 @@ func DecodeAddress(b []byte) (Type, error) {
 	default:
 		return nil, fmt.Errorf("Unsupported address family: 0x%x", b[:2])
 	}
-	return Address(b[2:]), nil
+	// Copy the address, as b may be reused once the message is read.
+	addr := make(Address, len(b)-2)
+	copy(addr, b[2:])
+	return addr, nil
 }
 
 // Serialize implements the Type interface.
@@ -61,7 +80,7 @@ func (addr Address) Len() int {
 
 // Padding implements the Type interface.
 func (addr Address) Padding() int {
-	l := len(addr) + 2 // two bytes from the address family
+	l := addr.Len()
 	return pad4(l) - l
 }
 
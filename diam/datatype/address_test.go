@@ -121,6 +121,31 @@ func TestDecodeAddressIPv6(t *testing.T) {
 	//t.Log(address)
 }
 
+func TestAddressPadding(t *testing.T) {
+	for _, test := range []struct {
+		Address Address
+		Len     int
+		Padding int
+	}{
+		{Address(net.ParseIP("10.0.0.1")), 6, 2},
+		{Address(net.ParseIP("10.0.0.1").To4()), 6, 2},
+		{Address(net.ParseIP("2001:db8::1")), 18, 2},
+	} {
+		if v := test.Address.Len(); v != test.Len {
+			t.Fatalf("Unexpected len for %s. Want %d, have %d",
+				net.IP(test.Address), test.Len, v)
+		}
+		if v := test.Address.Padding(); v != test.Padding {
+			t.Fatalf("Unexpected padding for %s. Want %d, have %d",
+				net.IP(test.Address), test.Padding, v)
+		}
+		if v := len(test.Address.Serialize()); v != test.Len {
+			t.Fatalf("Unexpected serialized len for %s. Want %d, have %d",
+				net.IP(test.Address), test.Len, v)
+		}
+	}
+}
+
 func BenchmarkAddressIPv4(b *testing.B) {
 	address := Address(net.ParseIP("10.0.0.1"))
 	for n := 0; n < b.N; n++ {
@@ -151,3 +176,21 @@ func BenchmarkDecodeAddressIPv6(b *testing.B) {
 		DecodeAddress(v)
 	}
 }
+
+func TestNewAddress(t *testing.T) {
+	a, err := NewAddress(net.ParseIP("10.0.0.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(a) != 4 {
+		t.Fatalf("Unexpected address length. Want 4, have %d", len(a))
+	}
+	if _, err = NewAddress(net.ParseIP("2001:db8::1")); err != nil {
+		t.Fatal(err)
+	}
+	for _, ip := range []net.IP{nil, net.IP{1, 2, 3}} {
+		if _, err = NewAddress(ip); err == nil {
+			t.Fatalf("Unexpected success with %#v", ip)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import "bytes"
+
+// Cloner is implemented by data types that hold references, such as
+// slices or pointers, and know how to make deep copies of themselves.
+type Cloner interface {
+	Clone() Type
+}
+
+// Clone returns a deep copy of t. Data types backed by byte slices
+// are copied, data types that implement Cloner are copied by their
+// Clone method, and other data types, which are plain values, are
+// returned as-is.
+func Clone(t Type) Type {
+	switch v := t.(type) {
+	case Cloner:
+		return v.Clone()
+	case Address:
+		return Address(cloneBytes(v))
+	case IPv4:
+		return IPv4(cloneBytes(v))
+	case Grouped:
+		return Grouped(cloneBytes(v))
+	case OctetBytes:
+		return OctetBytes(cloneBytes(v))
+	case Unsigned32List:
+		return append(Unsigned32List(nil), v...)
+	case Unsigned64List:
+		return append(Unsigned64List(nil), v...)
+	}
+	return t
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(b)), b...)
+}
+
+// Equal reports whether a and b have the same data type and encoding.
+// Equal values are encoded to the same bytes, so for example IPv4
+// addresses in their 4 and 16-byte forms are equal, NaN floats with the
+// same bits are equal, and times are compared with second precision.
+func Equal(a, b Type) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	return bytes.Equal(a.Serialize(), b.Serialize())
+}
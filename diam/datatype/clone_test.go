@@ -0,0 +1,60 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"math"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestClone(t *testing.T) {
+	addr := Address(net.ParseIP("10.0.0.1").To4())
+	c := Clone(addr).(Address)
+	c[0] = 192
+	if addr[0] != 10 {
+		t.Fatalf("Unexpected change of the original address: %s", net.IP(addr))
+	}
+	b := OctetBytes{1, 2, 3}
+	Clone(b).(OctetBytes)[0] = 9
+	if b[0] != 1 {
+		t.Fatalf("Unexpected change of the original bytes: %v", b)
+	}
+	l := Unsigned32List{1, 2}
+	Clone(l).(Unsigned32List)[0] = 9
+	if l[0] != 1 {
+		t.Fatalf("Unexpected change of the original list: %v", l)
+	}
+	if v := Clone(UTF8String("hello")); v != UTF8String("hello") {
+		t.Fatalf("Unexpected clone: %v", v)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	nan := Float64(math.NaN())
+	now := time.Now()
+	testCases := []struct {
+		a, b  Type
+		equal bool
+	}{
+		{Unsigned32(1), Unsigned32(1), true},
+		{Unsigned32(1), Unsigned32(2), false},
+		{Unsigned32(1), Integer32(1), false},
+		{OctetString("a"), UTF8String("a"), false},
+		{OctetString("a"), OctetBytes("a"), true},
+		{Address(net.ParseIP("10.0.0.1")), Address(net.ParseIP("10.0.0.1").To4()), true},
+		{nan, nan, true},
+		{Time(now), Time(now.Truncate(time.Second)), true},
+		{nil, nil, true},
+		{nil, Unsigned32(0), false},
+	}
+	for n, tc := range testCases {
+		if eq := Equal(tc.a, tc.b); eq != tc.equal {
+			t.Fatalf("Unexpected result #%d for %v and %v. Want %t, have %t",
+				n, tc.a, tc.b, tc.equal, eq)
+		}
+	}
+}
@@ -35,6 +35,8 @@ const (
 	UTF8StringType
 	Unsigned32Type
 	Unsigned64Type
+	QoSFilterRuleType
+	TBCDStringType
 )
 
 // Available is a map of data types available, indexed by name.
@@ -51,6 +53,8 @@ var Available = map[string]TypeID{
 	"Integer32":        Integer32Type,
 	"Integer64":        Integer64Type,
 	"OctetString":      OctetStringType,
+	"QoSFilterRule":    QoSFilterRuleType,
+	"TBCDString":       TBCDStringType,
 	"Time":             TimeType,
 	"UTF8String":       UTF8StringType,
 	"Unsigned32":       Unsigned32Type,
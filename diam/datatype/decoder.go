@@ -23,12 +23,23 @@ var Decoder = map[TypeID]DecoderFunc{
 	Integer32Type:        DecodeInteger32,
 	Integer64Type:        DecodeInteger64,
 	OctetStringType:      DecodeOctetString,
+	QoSFilterRuleType:    DecodeQoSFilterRule,
+	TBCDStringType:       DecodeTBCDString,
 	TimeType:             DecodeTime,
 	UTF8StringType:       DecodeUTF8String,
 	Unsigned32Type:       DecodeUnsigned32,
 	Unsigned64Type:       DecodeUnsigned64,
 }
 
+// checkLength returns an error if b is not n bytes long, as required by
+// fixed length data types.
+func checkLength(b []byte, n int, name string) error {
+	if len(b) != n {
+		return fmt.Errorf("Invalid length for %s: want %d, have %d", name, n, len(b))
+	}
+	return nil
+}
+
 // Decode decodes a specific AVP data type from byte array to a DataType.
 func Decode(Type TypeID, b []byte) (Type, error) {
 	f, exists := Decoder[Type]
@@ -37,3 +48,37 @@ func Decode(Type TypeID, b []byte) (Type, error) {
 	}
 	return f(b)
 }
+
+// DecodeOptions are policies for decoding data types that are not
+// fully specified by the RFCs, where peers are known to differ. The
+// zero value decodes as Decode does.
+type DecodeOptions struct {
+	UTF8    UTF8Policy  // Handling of UTF8String data that is not valid UTF-8
+	TimeEra EraMapping  // Mapping of Time values to NTP eras
+	Float   FloatPolicy // Handling of NaN and infinite Float32 and Float64 data
+
+	// OctetBytes decodes OctetString data as OctetBytes, instead of
+	// OctetString.
+	OctetBytes bool
+}
+
+// Decode decodes a specific AVP data type from byte array according to
+// the options. Options that are not the zero value take precedence over
+// the functions in Decoder for their data types. A nil *DecodeOptions
+// decodes as Decode does.
+func (o *DecodeOptions) Decode(Type TypeID, b []byte) (Type, error) {
+	switch {
+	case o == nil:
+	case Type == UTF8StringType && o.UTF8 != StrictUTF8:
+		return decodeUTF8String(b, o.UTF8)
+	case Type == TimeType && o.TimeEra != EraRFC4330:
+		return decodeTime(b, o.TimeEra)
+	case Type == Float32Type && o.Float == RejectNonFiniteFloat:
+		return DecodeFiniteFloat32(b)
+	case Type == Float64Type && o.Float == RejectNonFiniteFloat:
+		return DecodeFiniteFloat64(b)
+	case Type == OctetStringType && o.OctetBytes:
+		return DecodeOctetBytes(b)
+	}
+	return Decode(Type, b)
+}
@@ -9,6 +9,15 @@ import "fmt"
 // DiameterIdentity data type.
 type DiameterIdentity OctetString
 
+// NewDiameterIdentity returns s as a DiameterIdentity, or an error if s
+// is not a valid FQDN or realm name. See RFC 6733 section 4.3.1.
+func NewDiameterIdentity(s string) (DiameterIdentity, error) {
+	if !isFQDN(s) {
+		return "", fmt.Errorf("Invalid DiameterIdentity: %q", s)
+	}
+	return DiameterIdentity(s), nil
+}
+
 // DecodeDiameterIdentity decodes a DiameterIdentity from byte array.
 func DecodeDiameterIdentity(b []byte) (Type, error) {
 	return DiameterIdentity(b), nil
@@ -52,3 +52,14 @@ func TestDecodeDiameterIdentity(t *testing.T) {
 		t.Fatalf("Unexpected string. Want 'hello, world', have %q", v)
 	}
 }
+
+func TestNewDiameterIdentity(t *testing.T) {
+	if _, err := NewDiameterIdentity("host.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"", "host..example.com", "host name", "-host"} {
+		if _, err := NewDiameterIdentity(s); err == nil {
+			t.Fatalf("Unexpected success with %q", s)
+		}
+	}
+}
@@ -4,9 +4,17 @@
 
 package datatype
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // DiameterURI data type.
+//
+// The URI is kept in its text form. Use Parse to split it into
+// structured fields, and NewDiameterURI to build valid URIs.
+// See RFC 6733 section 4.3.1.
 type DiameterURI OctetString
 
 // DecodeDiameterURI decodes a DiameterURI from byte array.
@@ -39,3 +47,175 @@ func (s DiameterURI) Type() TypeID {
 func (s DiameterURI) String() string {
 	return fmt.Sprintf("DiameterURI{%s},Padding:%d", string(s), s.Padding())
 }
+
+// Parse parses the URI into a URI struct.
+func (s DiameterURI) Parse() (*URI, error) {
+	return ParseDiameterURI(string(s))
+}
+
+// Default values of DiameterURI fields, per RFC 6733 section 4.3.1.
+const (
+	DefaultURIPort       = 3868
+	DefaultSecureURIPort = 5658
+	DefaultURITransport  = "tcp"
+	DefaultURIProtocol   = "diameter"
+)
+
+// URI is a parsed DiameterURI, in the form:
+//
+//	aaa://FQDN[:port][;transport=tcp|sctp|udp][;protocol=diameter|radius|tacacs+]
+//
+// For example "aaas://host.example.com:5658;transport=tcp".
+type URI struct {
+	Scheme    string // "aaa" or "aaas"
+	FQDN      string // fully qualified domain name of the node
+	Port      int    // port number
+	Transport string // "tcp", "sctp" or "udp"
+	Protocol  string // "diameter", "radius" or "tacacs+"
+}
+
+// ParseDiameterURI parses and validates a DiameterURI. Optional fields
+// that are absent from the URI are set to their defaults: port 3868 for
+// aaa and 5658 for aaas, transport tcp and protocol diameter.
+func ParseDiameterURI(uri string) (*URI, error) {
+	i := strings.Index(uri, "://")
+	if i < 0 {
+		return nil, fmt.Errorf("missing scheme in DiameterURI: %q", uri)
+	}
+	u := &URI{Scheme: strings.ToLower(uri[:i])}
+	params := strings.Split(uri[i+3:], ";")
+	host := params[0]
+	if n := strings.LastIndex(host, ":"); n >= 0 {
+		port, err := strconv.Atoi(host[n+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid port in DiameterURI: %q", uri)
+		}
+		u.FQDN, u.Port = host[:n], port
+	} else {
+		u.FQDN = host
+	}
+	for _, param := range params[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid parameter in DiameterURI: %q", param)
+		}
+		switch v := strings.ToLower(kv[1]); strings.ToLower(kv[0]) {
+		case "transport":
+			if u.Transport != "" {
+				return nil, fmt.Errorf("duplicate transport in DiameterURI: %q", uri)
+			}
+			u.Transport = v
+		case "protocol":
+			if u.Protocol != "" {
+				return nil, fmt.Errorf("duplicate protocol in DiameterURI: %q", uri)
+			}
+			u.Protocol = v
+		default:
+			return nil, fmt.Errorf("unknown parameter in DiameterURI: %q", param)
+		}
+	}
+	if u.Port == 0 {
+		u.Port = DefaultURIPort
+		if u.Scheme == "aaas" {
+			u.Port = DefaultSecureURIPort
+		}
+	}
+	if u.Transport == "" {
+		u.Transport = DefaultURITransport
+	}
+	if u.Protocol == "" {
+		u.Protocol = DefaultURIProtocol
+	}
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// NewDiameterURI builds and validates a DiameterURI, for example to be
+// used in Redirect-Host AVPs. Port, transport and protocol are optional
+// and are omitted from the URI when set to 0 or the empty string.
+func NewDiameterURI(scheme, fqdn string, port int, transport, protocol string) (DiameterURI, error) {
+	u := &URI{
+		Scheme:    scheme,
+		FQDN:      fqdn,
+		Port:      port,
+		Transport: transport,
+		Protocol:  protocol,
+	}
+	if err := u.validate(true); err != nil {
+		return "", err
+	}
+	return DiameterURI(u.String()), nil
+}
+
+// Validate checks that all fields of the URI are set to valid values.
+func (u *URI) Validate() error {
+	return u.validate(false)
+}
+
+func (u *URI) validate(optional bool) error {
+	if u.Scheme != "aaa" && u.Scheme != "aaas" {
+		return fmt.Errorf("invalid DiameterURI scheme: %q", u.Scheme)
+	}
+	if !isFQDN(u.FQDN) {
+		return fmt.Errorf("invalid DiameterURI FQDN: %q", u.FQDN)
+	}
+	if (u.Port != 0 || !optional) && (u.Port < 1 || u.Port > 65535) {
+		return fmt.Errorf("invalid DiameterURI port: %d", u.Port)
+	}
+	switch u.Transport {
+	case "tcp", "sctp", "udp":
+	default:
+		if u.Transport != "" || !optional {
+			return fmt.Errorf("invalid DiameterURI transport: %q", u.Transport)
+		}
+	}
+	switch u.Protocol {
+	case "diameter", "radius", "tacacs+":
+	default:
+		if u.Protocol != "" || !optional {
+			return fmt.Errorf("invalid DiameterURI protocol: %q", u.Protocol)
+		}
+	}
+	return nil
+}
+
+// String returns the URI in its text form. Fields set to 0 or the
+// empty string are omitted.
+func (u *URI) String() string {
+	s := u.Scheme + "://" + u.FQDN
+	if u.Port != 0 {
+		s += ":" + strconv.Itoa(u.Port)
+	}
+	if u.Transport != "" {
+		s += ";transport=" + u.Transport
+	}
+	if u.Protocol != "" {
+		s += ";protocol=" + u.Protocol
+	}
+	return s
+}
+
+// isFQDN reports whether s is a valid domain name as per RFC 1123.
+func isFQDN(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > 63 ||
+			label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z',
+				c >= '0' && c <= '9', c == '-':
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -52,3 +52,70 @@ func TestDecodeDiameterURI(t *testing.T) {
 		t.Fatalf("Unexpected string. Want 'hello, world', have %q", v)
 	}
 }
+
+func TestParseDiameterURI(t *testing.T) {
+	testCases := []struct {
+		uri  string
+		want URI
+	}{
+		{"aaa://host.example.com", URI{"aaa", "host.example.com", 3868, "tcp", "diameter"}},
+		{"aaas://host.example.com", URI{"aaas", "host.example.com", 5658, "tcp", "diameter"}},
+		{"aaa://host.example.com:6666;transport=sctp", URI{"aaa", "host.example.com", 6666, "sctp", "diameter"}},
+		{"aaa://host.example.com;protocol=radius;transport=udp", URI{"aaa", "host.example.com", 3868, "udp", "radius"}},
+		{"AAA://Host-1.example.com:1813;transport=UDP;protocol=radius", URI{"aaa", "Host-1.example.com", 1813, "udp", "radius"}},
+	}
+	for _, tc := range testCases {
+		u, err := DiameterURI(tc.uri).Parse()
+		if err != nil {
+			t.Fatalf("%s: %v", tc.uri, err)
+		}
+		if *u != tc.want {
+			t.Fatalf("Unexpected URI for %q. Want %#v, have %#v", tc.uri, tc.want, *u)
+		}
+	}
+}
+
+func TestParseDiameterURIErrors(t *testing.T) {
+	for _, uri := range []string{
+		"host.example.com",
+		"http://host.example.com",
+		"aaa://",
+		"aaa://-host.example.com",
+		"aaa://host..example.com",
+		"aaa://host_1.example.com",
+		"aaa://host.example.com:",
+		"aaa://host.example.com:70000",
+		"aaa://host.example.com;transport=quic",
+		"aaa://host.example.com;protocol=ldap",
+		"aaa://host.example.com;transport=tcp;transport=sctp",
+		"aaa://host.example.com;foo=bar",
+		"aaa://host.example.com;transport",
+	} {
+		if _, err := ParseDiameterURI(uri); err == nil {
+			t.Fatalf("Unexpected success parsing %q", uri)
+		}
+	}
+}
+
+func TestNewDiameterURI(t *testing.T) {
+	u, err := NewDiameterURI("aaa", "host.example.com", 0, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != "aaa://host.example.com" {
+		t.Fatalf("Unexpected URI. Want aaa://host.example.com, have %s", u)
+	}
+	u, err = NewDiameterURI("aaas", "host.example.com", 5658, "tcp", "diameter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "aaas://host.example.com:5658;transport=tcp;protocol=diameter"; string(u) != want {
+		t.Fatalf("Unexpected URI. Want %s, have %s", want, u)
+	}
+	if _, err = u.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewDiameterURI("aaa", "host.example.com", 0, "quic", ""); err == nil {
+		t.Fatal("Unexpected success with invalid transport")
+	}
+}
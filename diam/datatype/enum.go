@@ -4,15 +4,30 @@
 
 package datatype
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // Enumerated data type.
 type Enumerated Integer32
 
+// NewEnumerated returns v as an Enumerated, or an error if v does not
+// fit in the 32-bit signed integer of the encoding.
+func NewEnumerated(v int) (Enumerated, error) {
+	if int64(v) < math.MinInt32 || int64(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("Enumerated value out of range: %d", v)
+	}
+	return Enumerated(v), nil
+}
+
 // DecodeEnumerated decodes an Enumerated data type from byte array.
 func DecodeEnumerated(b []byte) (Type, error) {
 	v, err := DecodeInteger32(b)
-	return Enumerated(v.(Integer32)), err
+	if err != nil {
+		return nil, err
+	}
+	return Enumerated(v.(Integer32)), nil
 }
 
 // Serialize implements the Type interface.
@@ -35,6 +50,31 @@ func (n Enumerated) Type() TypeID {
 	return EnumeratedType
 }
 
+// EnumDictionary is implemented by dictionaries that map Enumerated
+// values to names, such as dict.Parser.
+type EnumDictionary interface {
+	EnumName(appid, code uint32, value int32) (string, error)
+	EnumValue(appid, code uint32, name string) (int32, error)
+}
+
+// Name returns the symbolic name of n as a value of the AVP with the
+// given application id and code in the dictionary d. For example,
+// the name of Enumerated(1) for CC-Request-Type is "INITIAL_REQUEST".
+func (n Enumerated) Name(d EnumDictionary, appid, code uint32) (string, error) {
+	return d.EnumName(appid, code, int32(n))
+}
+
+// ParseEnumerated returns the Enumerated value with the given symbolic
+// name, as a value of the AVP with the given application id and code in
+// the dictionary d. It is the inverse of Enumerated.Name.
+func ParseEnumerated(d EnumDictionary, appid, code uint32, name string) (Enumerated, error) {
+	v, err := d.EnumValue(appid, code, name)
+	if err != nil {
+		return 0, err
+	}
+	return Enumerated(v), nil
+}
+
 // String implements the Type interface.
 func (n Enumerated) String() string {
 	return fmt.Sprintf("Enumerated{%d}", n)
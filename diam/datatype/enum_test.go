@@ -6,6 +6,7 @@ package datatype
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 )
 
@@ -46,3 +47,56 @@ func TestDecodeEnumerated(t *testing.T) {
 		t.Fatalf("Unexpected padding. Want 0, have %d", s.Padding())
 	}
 }
+
+// testEnumDictionary maps the values of a single Enumerated AVP.
+type testEnumDictionary map[int32]string
+
+func (d testEnumDictionary) EnumName(appid, code uint32, value int32) (string, error) {
+	if name, ok := d[value]; ok {
+		return name, nil
+	}
+	return "", fmt.Errorf("unknown value %d", value)
+}
+
+func (d testEnumDictionary) EnumValue(appid, code uint32, name string) (int32, error) {
+	for v, n := range d {
+		if n == name {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown name %s", name)
+}
+
+func TestEnumeratedName(t *testing.T) {
+	d := testEnumDictionary{1: "INITIAL_REQUEST", 4: "EVENT_REQUEST"}
+	name, err := Enumerated(4).Name(d, 4, 416)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "EVENT_REQUEST" {
+		t.Fatalf("Unexpected name. Want EVENT_REQUEST, have %s", name)
+	}
+	v, err := ParseEnumerated(d, 4, 416, "INITIAL_REQUEST")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Fatalf("Unexpected value. Want 1, have %d", v)
+	}
+	if _, err = ParseEnumerated(d, 4, 416, "FOO"); err == nil {
+		t.Fatal("Unexpected value for FOO")
+	}
+}
+
+func TestNewEnumerated(t *testing.T) {
+	n, err := NewEnumerated(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != -1 {
+		t.Fatalf("Unexpected value. Want -1, have %d", n)
+	}
+	if _, err = NewEnumerated(1 << 31); err == nil {
+		t.Fatal("Unexpected success with a value out of range")
+	}
+}
@@ -6,18 +6,58 @@ package datatype
 
 import (
 	"encoding/binary"
-	"fmt"
+	"errors"
 	"math"
+	"strconv"
+)
+
+// ErrNonFiniteFloat is returned by DecodeFiniteFloat32 and
+// DecodeFiniteFloat64 when the data is NaN or an infinity.
+var ErrNonFiniteFloat = errors.New("Float is NaN or infinite")
+
+// FloatPolicy is a policy for handling NaN and infinite values in
+// Float32 and Float64 AVPs. See DecodeOptions.
+type FloatPolicy int
+
+// Float policies.
+const (
+	// AllowNonFiniteFloat decodes NaN and infinities as-is.
+	AllowNonFiniteFloat FloatPolicy = iota
+
+	// RejectNonFiniteFloat rejects NaN and infinities with an
+	// ErrNonFiniteFloat error.
+	RejectNonFiniteFloat
 )
 
 // Float32 data type.
+//
+// IEEE 754 special values, NaN and infinities, are encoded and decoded
+// as-is, preserving their bits. Note that NaN is not equal to any value,
+// including itself. To reject them when decoding, set DecodeOptions.Float
+// to RejectNonFiniteFloat.
 type Float32 float32
 
 // DecodeFloat32 decodes a Float32 data type from a byte array.
 func DecodeFloat32(b []byte) (Type, error) {
+	if err := checkLength(b, 4, "Float32"); err != nil {
+		return nil, err
+	}
 	return Float32(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
 }
 
+// DecodeFiniteFloat32 decodes a Float32 data type from a byte array,
+// and returns ErrNonFiniteFloat if it is NaN or an infinity.
+func DecodeFiniteFloat32(b []byte) (Type, error) {
+	v, err := DecodeFloat32(b)
+	if err != nil {
+		return nil, err
+	}
+	if f := float64(v.(Float32)); math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrNonFiniteFloat
+	}
+	return v, nil
+}
+
 // Serialize implements the Type interface.
 func (n Float32) Serialize() []byte {
 	b := make([]byte, 4)
@@ -40,7 +80,16 @@ func (n Float32) Type() TypeID {
 	return Float32Type
 }
 
-// String implements the Type interface.
+// String implements the Type interface. It renders the shortest text
+// that decodes to the same value, with an exponent for large and small
+// values. See Format for other formats.
 func (n Float32) String() string {
-	return fmt.Sprintf("Float32{%0.4f}", n)
+	return n.Format('g', -1)
+}
+
+// Format renders n like String, with fmt and prec as the arguments of
+// strconv.FormatFloat. For example, 'e' and 3 render scientific
+// notation with three decimals, and 'f' and 2 fixed notation with two.
+func (n Float32) Format(fmt byte, prec int) string {
+	return "Float32{" + strconv.FormatFloat(float64(n), fmt, prec, 32) + "}"
 }
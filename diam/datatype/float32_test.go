@@ -6,6 +6,7 @@ package datatype
 
 import (
 	"bytes"
+	"math"
 	"testing"
 )
 
@@ -41,6 +42,34 @@ func TestDecodeFloat32(t *testing.T) {
 	}
 }
 
+func TestFloat32SpecialValues(t *testing.T) {
+	for _, b := range [][]byte{
+		{0x7f, 0xc0, 0x00, 0x01}, // NaN with payload
+		{0xff, 0x80, 0x00, 0x00}, // Infinity
+	} {
+		n, err := DecodeFloat32(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := n.Serialize(); !bytes.Equal(v, b) {
+			t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+		}
+		if _, err = DecodeFiniteFloat32(b); err != ErrNonFiniteFloat {
+			t.Fatalf("Unexpected error. Want %v, have %v", ErrNonFiniteFloat, err)
+		}
+		opts := &DecodeOptions{Float: RejectNonFiniteFloat}
+		if _, err = opts.Decode(Float32Type, b); err != ErrNonFiniteFloat {
+			t.Fatalf("Unexpected error. Want %v, have %v", ErrNonFiniteFloat, err)
+		}
+	}
+	if v := Float32(math.NaN()); v == v {
+		t.Fatal("NaN is equal to itself")
+	}
+	if _, err := DecodeFiniteFloat32([]byte{0x40, 0x49, 0x0e, 0x56}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func BenchmarkFloat32(b *testing.B) {
 	v := Float32(3.1415)
 	for n := 0; n < b.N; n++ {
@@ -54,3 +83,12 @@ func BenchmarkDecodeFloat32(b *testing.B) {
 		DecodeFloat32(v)
 	}
 }
+
+func TestFloat32String(t *testing.T) {
+	if s := Float32(0.1).String(); s != "Float32{0.1}" {
+		t.Fatalf("Unexpected string. Want Float32{0.1}, have %s", s)
+	}
+	if s := Float32(1234.5).Format('e', 2); s != "Float32{1.23e+03}" {
+		t.Fatalf("Unexpected string. Want Float32{1.23e+03}, have %s", s)
+	}
+}
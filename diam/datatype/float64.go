@@ -6,18 +6,37 @@ package datatype
 
 import (
 	"encoding/binary"
-	"fmt"
 	"math"
+	"strconv"
 )
 
 // Float64 data type.
+//
+// Like Float32, NaN and infinities are encoded and decoded as-is, unless
+// DecodeOptions.Float is RejectNonFiniteFloat.
 type Float64 float64
 
 // DecodeFloat64 decodes a Float64 data type from byte array.
 func DecodeFloat64(b []byte) (Type, error) {
+	if err := checkLength(b, 8, "Float64"); err != nil {
+		return nil, err
+	}
 	return Float64(math.Float64frombits(binary.BigEndian.Uint64(b))), nil
 }
 
+// DecodeFiniteFloat64 decodes a Float64 data type from byte array,
+// and returns ErrNonFiniteFloat if it is NaN or an infinity.
+func DecodeFiniteFloat64(b []byte) (Type, error) {
+	v, err := DecodeFloat64(b)
+	if err != nil {
+		return nil, err
+	}
+	if f := float64(v.(Float64)); math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrNonFiniteFloat
+	}
+	return v, nil
+}
+
 // Serialize implements the Type interface.
 func (n Float64) Serialize() []byte {
 	b := make([]byte, 8)
@@ -40,7 +59,13 @@ func (n Float64) Type() TypeID {
 	return Float64Type
 }
 
-// String implements the Type interface.
+// String implements the Type interface. See Float32.String.
 func (n Float64) String() string {
-	return fmt.Sprintf("Float64{%0.4f}", n)
+	return n.Format('g', -1)
+}
+
+// Format renders n like String, with fmt and prec as the arguments of
+// strconv.FormatFloat. See Float32.Format.
+func (n Float64) Format(fmt byte, prec int) string {
+	return "Float64{" + strconv.FormatFloat(float64(n), fmt, prec, 64) + "}"
 }
@@ -6,6 +6,7 @@ package datatype
 
 import (
 	"bytes"
+	"math"
 	"testing"
 )
 
@@ -41,6 +42,34 @@ func TestDecodeFloat64(t *testing.T) {
 	}
 }
 
+func TestFloat64SpecialValues(t *testing.T) {
+	for _, b := range [][]byte{
+		{0x7f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, // NaN with payload
+		{0x7f, 0xf0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, // Infinity
+	} {
+		n, err := DecodeFloat64(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := n.Serialize(); !bytes.Equal(v, b) {
+			t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+		}
+		if _, err = DecodeFiniteFloat64(b); err != ErrNonFiniteFloat {
+			t.Fatalf("Unexpected error. Want %v, have %v", ErrNonFiniteFloat, err)
+		}
+		opts := &DecodeOptions{Float: RejectNonFiniteFloat}
+		if _, err = opts.Decode(Float64Type, b); err != ErrNonFiniteFloat {
+			t.Fatalf("Unexpected error. Want %v, have %v", ErrNonFiniteFloat, err)
+		}
+	}
+	if v := Float64(math.NaN()); v == v {
+		t.Fatal("NaN is equal to itself")
+	}
+	if _, err := DecodeFiniteFloat64([]byte{0x40, 0x09, 0x21, 0xca, 0xc0, 0x83, 0x12, 0x6f}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func BenchmarkFloat64(b *testing.B) {
 	v := Float64(3.1415926535)
 	for n := 0; n < b.N; n++ {
@@ -54,3 +83,12 @@ func BenchmarkDecodeFloat64(b *testing.B) {
 		DecodeFloat64(v)
 	}
 }
+
+func TestFloat64String(t *testing.T) {
+	if s := Float64(1e-9).String(); s != "Float64{1e-09}" {
+		t.Fatalf("Unexpected string. Want Float64{1e-09}, have %s", s)
+	}
+	if s := Float64(1234.5).Format('e', 2); s != "Float64{1.23e+03}" {
+		t.Fatalf("Unexpected string. Want Float64{1.23e+03}, have %s", s)
+	}
+}
@@ -14,6 +14,9 @@ type Integer32 int32
 
 // DecodeInteger32 decodes an Integer32 data type from byte array.
 func DecodeInteger32(b []byte) (Type, error) {
+	if err := checkLength(b, 4, "Integer32"); err != nil {
+		return nil, err
+	}
 	return Integer32(binary.BigEndian.Uint32(b)), nil
 }
 
@@ -14,6 +14,9 @@ type Integer64 int64
 
 // DecodeInteger64 decodes an Integer64 data type from byte array.
 func DecodeInteger64(b []byte) (Type, error) {
+	if err := checkLength(b, 8, "Integer64"); err != nil {
+		return nil, err
+	}
 	return Integer64(binary.BigEndian.Uint64(b)), nil
 }
 
@@ -12,9 +12,24 @@ import (
 // IPv4 data type for Framed-IP-Address AVP.
 type IPv4 net.IP
 
+// NewIPv4 returns ip as an IPv4, or an error if ip is not an IPv4
+// address. The address is stored in its 4-byte form.
+func NewIPv4(ip net.IP) (IPv4, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("Invalid IPv4 address: %v", ip)
+	}
+	return IPv4(ip4), nil
+}
+
 // DecodeIPv4 decodes an IPv4 data type from byte array.
 func DecodeIPv4(b []byte) (Type, error) {
-	return IPv4(b), nil
+	if err := checkLength(b, 4, "IPv4"); err != nil {
+		return nil, err
+	}
+	ip := make(IPv4, len(b))
+	copy(ip, b)
+	return ip, nil
 }
 
 // Serialize implements the Type interface.
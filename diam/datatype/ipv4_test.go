@@ -52,3 +52,16 @@ func TestDecodeIPv4(t *testing.T) {
 		t.Fatalf("Unexpected value. Want 10.0.0.1, have %s", ip)
 	}
 }
+
+func TestNewIPv4(t *testing.T) {
+	ip, err := NewIPv4(net.ParseIP("10.0.0.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ip) != 4 {
+		t.Fatalf("Unexpected address length. Want 4, have %d", len(ip))
+	}
+	if _, err = NewIPv4(net.ParseIP("2001:db8::1")); err == nil {
+		t.Fatal("Unexpected success with an IPv6 address")
+	}
+}
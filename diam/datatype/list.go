@@ -0,0 +1,121 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Repeated is implemented by data types that hold a list of values to
+// be encoded as repeated AVPs with the same code, one per value, such
+// as Unsigned32List. diam.Message.NewAVP adds one AVP per value, and
+// a diam.AVP with Repeated data, for example in a Grouped AVP, is
+// serialized as one AVP per value.
+type Repeated interface {
+	Type
+	Values() []Type
+}
+
+// Unsigned32List is a list of Unsigned32 values, for repeated AVPs
+// such as Auth-Application-Id or Supported-Vendor-Id. Repeated AVPs can
+// be decoded to an Unsigned32List with diam.Message.Unmarshal.
+//
+// Its Serialize method returns the concatenation of its values, which
+// is not a valid AVP payload on its own.
+type Unsigned32List []Unsigned32
+
+// Values implements the Repeated interface.
+func (l Unsigned32List) Values() []Type {
+	v := make([]Type, len(l))
+	for n, item := range l {
+		v[n] = item
+	}
+	return v
+}
+
+// Serialize implements the Type interface.
+func (l Unsigned32List) Serialize() []byte {
+	return serializeList(l.Values())
+}
+
+// Len implements the Type interface.
+func (l Unsigned32List) Len() int {
+	return 4 * len(l)
+}
+
+// Padding implements the Type interface.
+func (l Unsigned32List) Padding() int {
+	return 0
+}
+
+// Type implements the Type interface.
+func (l Unsigned32List) Type() TypeID {
+	return Unsigned32Type
+}
+
+// String implements the Type interface.
+func (l Unsigned32List) String() string {
+	return listString("Unsigned32List", l.Values())
+}
+
+// Unsigned64List is a list of Unsigned64 values, for repeated AVPs.
+// See Unsigned32List for details.
+type Unsigned64List []Unsigned64
+
+// Values implements the Repeated interface.
+func (l Unsigned64List) Values() []Type {
+	v := make([]Type, len(l))
+	for n, item := range l {
+		v[n] = item
+	}
+	return v
+}
+
+// Serialize implements the Type interface.
+func (l Unsigned64List) Serialize() []byte {
+	return serializeList(l.Values())
+}
+
+// Len implements the Type interface.
+func (l Unsigned64List) Len() int {
+	return 8 * len(l)
+}
+
+// Padding implements the Type interface.
+func (l Unsigned64List) Padding() int {
+	return 0
+}
+
+// Type implements the Type interface.
+func (l Unsigned64List) Type() TypeID {
+	return Unsigned64Type
+}
+
+// String implements the Type interface.
+func (l Unsigned64List) String() string {
+	return listString("Unsigned64List", l.Values())
+}
+
+func serializeList(values []Type) []byte {
+	var b bytes.Buffer
+	for _, v := range values {
+		b.Write(v.Serialize())
+	}
+	return b.Bytes()
+}
+
+func listString(name string, values []Type) string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s{", name)
+	for n, v := range values {
+		if n > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(v.String())
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -0,0 +1,38 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUnsigned32List(t *testing.T) {
+	l := Unsigned32List{4, 10415}
+	b := []byte{0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x28, 0xaf}
+	if v := l.Serialize(); !bytes.Equal(v, b) {
+		t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+	}
+	if l.Len() != 8 {
+		t.Fatalf("Unexpected len. Want 8, have %d", l.Len())
+	}
+	values := l.Values()
+	if len(values) != 2 || values[1] != Unsigned32(10415) {
+		t.Fatalf("Unexpected values: %v", values)
+	}
+	if len(l.String()) == 0 {
+		t.Fatalf("Unexpected empty string")
+	}
+}
+
+func TestUnsigned64List(t *testing.T) {
+	l := Unsigned64List{1, 2}
+	if l.Len() != 16 || len(l.Serialize()) != 16 {
+		t.Fatalf("Unexpected len. Want 16, have %d", l.Len())
+	}
+	if values := l.Values(); len(values) != 2 || values[0] != Unsigned64(1) {
+		t.Fatalf("Unexpected values: %v", values)
+	}
+}
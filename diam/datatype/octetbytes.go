@@ -0,0 +1,54 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import "fmt"
+
+// OctetBytes is a variant of the OctetString data type backed by a
+// byte slice, for binary payloads that are built or modified in place.
+//
+// OctetBytes values are serialized without copying, so the slice must
+// not be modified while a message that contains it is being written.
+// Padding is not part of the value; it is added by the AVP when the
+// message is serialized, and is reported by Padding.
+//
+// OctetString AVPs are decoded as OctetString by default. Set
+// DecodeOptions.OctetBytes to have them decoded as OctetBytes instead.
+type OctetBytes []byte
+
+// DecodeOctetBytes decodes an OctetString data type from byte array to
+// OctetBytes. The data is copied because message buffers are reused.
+func DecodeOctetBytes(b []byte) (Type, error) {
+	v := make(OctetBytes, len(b))
+	copy(v, b)
+	return v, nil
+}
+
+// Serialize implements the Type interface. It returns the underlying
+// byte slice.
+func (s OctetBytes) Serialize() []byte {
+	return []byte(s)
+}
+
+// Len implements the Type interface.
+func (s OctetBytes) Len() int {
+	return len(s)
+}
+
+// Padding implements the Type interface.
+func (s OctetBytes) Padding() int {
+	l := len(s)
+	return pad4(l) - l
+}
+
+// Type implements the Type interface.
+func (s OctetBytes) Type() TypeID {
+	return OctetStringType
+}
+
+// String implements the Type interface.
+func (s OctetBytes) String() string {
+	return fmt.Sprintf("OctetBytes{%#x},Padding:%d", []byte(s), s.Padding())
+}
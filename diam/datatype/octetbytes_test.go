@@ -0,0 +1,61 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestOctetBytes(t *testing.T) {
+	b := []byte{0x00, 0xff, 0x01}
+	s := OctetBytes(b)
+	if v := s.Serialize(); &v[0] != &b[0] {
+		t.Fatalf("Unexpected copy of the value")
+	}
+	if s.Len() != 3 {
+		t.Fatalf("Unexpected len. Want 3, have %d", s.Len())
+	}
+	if s.Padding() != 1 {
+		t.Fatalf("Unexpected padding. Want 1, have %d", s.Padding())
+	}
+	if s.Type() != OctetStringType {
+		t.Fatalf("Unexpected type. Want %d, have %d",
+			OctetStringType, s.Type())
+	}
+	if len(s.String()) == 0 {
+		t.Fatalf("Unexpected empty string")
+	}
+}
+
+func TestDecodeOctetBytes(t *testing.T) {
+	b := []byte{0x00, 0xff, 0x01, 0x02}
+	s, err := DecodeOctetBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := s.(OctetBytes)
+	if !bytes.Equal(v, b) {
+		t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+	}
+	b[0] = 0xaa
+	if v[0] != 0x00 {
+		t.Fatalf("Unexpected value shared with the decoded buffer")
+	}
+	if s.Padding() != 0 {
+		t.Fatalf("Unexpected padding. Want 0, have %d", s.Padding())
+	}
+}
+
+func TestDecodeOptionsOctetBytes(t *testing.T) {
+	opts := &DecodeOptions{OctetBytes: true}
+	v, err := opts.Decode(OctetStringType, []byte("abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := v.(OctetBytes); !ok || !bytes.Equal(b, []byte("abc")) {
+		t.Fatalf("Unexpected value. Want OctetBytes{abc}, have %#v", v)
+	}
+}
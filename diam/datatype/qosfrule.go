@@ -0,0 +1,222 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// QoSFilterRule data type.
+//
+// The rule is kept in its text form. Use Parse to split it into
+// structured fields. See RFC 4005 section 4.1.1 and RFC 7155.
+type QoSFilterRule OctetString
+
+// DecodeQoSFilterRule decodes a QoSFilterRule data type from byte array.
+func DecodeQoSFilterRule(b []byte) (Type, error) {
+	return QoSFilterRule(OctetString(b)), nil
+}
+
+// Serialize implements the Type interface.
+func (s QoSFilterRule) Serialize() []byte {
+	return OctetString(s).Serialize()
+}
+
+// Len implements the Type interface.
+func (s QoSFilterRule) Len() int {
+	return len(s)
+}
+
+// Padding implements the Type interface.
+func (s QoSFilterRule) Padding() int {
+	l := len(s)
+	return pad4(l) - l
+}
+
+// Type implements the Type interface.
+func (s QoSFilterRule) Type() TypeID {
+	return QoSFilterRuleType
+}
+
+// String implements the Type interface.
+func (s QoSFilterRule) String() string {
+	return fmt.Sprintf("QoSFilterRule{%s},Padding:%d", string(s), s.Padding())
+}
+
+// Parse parses the rule into a QoSFilter.
+func (s QoSFilterRule) Parse() (*QoSFilter, error) {
+	return ParseQoSFilter(string(s))
+}
+
+// QoSFilter is a parsed QoSFilterRule, in the form:
+//
+//	action dir proto from src to dst [options]
+//
+// For example "tag in ip from any to 10.0.0.0/8 80,443 DSCP 0x2e".
+type QoSFilter struct {
+	Action    string          // "tag" or "meter"
+	Direction string          // "in" or "out"
+	Proto     string          // "ip" or an IP protocol number
+	Src       QoSFilterAddr   // source
+	Dst       QoSFilterAddr   // destination
+	DSCP      string          // DSCP color, required by "tag"
+	Metering  *QoSFilterMeter // metering options, required by "meter"
+}
+
+// QoSFilterAddr is the source or destination of a QoSFilter.
+type QoSFilterAddr struct {
+	Not   bool     // address is preceded by "!"
+	Addr  string   // "any", "assigned", ipno or ipno/bits
+	Ports []string // port numbers or ranges such as "1000-2000"
+}
+
+// QoSFilterMeter contains the metering options of a QoSFilter.
+type QoSFilterMeter struct {
+	Rate       uint64 // rate in bits per second
+	ColorUnder string // DSCP color of traffic under the rate
+	ColorOver  string // DSCP color of traffic over the rate
+}
+
+// ParseQoSFilter parses the text form of a QoSFilterRule.
+func ParseQoSFilter(rule string) (*QoSFilter, error) {
+	fields := strings.Fields(rule)
+	if len(fields) < 7 {
+		return nil, fmt.Errorf("QoSFilterRule too short: %q", rule)
+	}
+	f := &QoSFilter{
+		Action:    fields[0],
+		Direction: fields[1],
+		Proto:     fields[2],
+	}
+	switch f.Action {
+	case "tag", "meter":
+	default:
+		return nil, fmt.Errorf("invalid QoSFilterRule action: %q", f.Action)
+	}
+	switch f.Direction {
+	case "in", "out":
+	default:
+		return nil, fmt.Errorf("invalid QoSFilterRule direction: %q", f.Direction)
+	}
+	if f.Proto != "ip" {
+		if _, err := strconv.ParseUint(f.Proto, 10, 8); err != nil {
+			return nil, fmt.Errorf("invalid QoSFilterRule protocol: %q", f.Proto)
+		}
+	}
+	if fields[3] != "from" {
+		return nil, fmt.Errorf("missing \"from\" in QoSFilterRule: %q", rule)
+	}
+	var err error
+	fields, err = parseQoSFilterAddr(fields[4:], &f.Src)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 || fields[0] != "to" {
+		return nil, fmt.Errorf("missing \"to\" in QoSFilterRule: %q", rule)
+	}
+	fields, err = parseQoSFilterAddr(fields[1:], &f.Dst)
+	if err != nil {
+		return nil, err
+	}
+	for len(fields) > 0 {
+		switch strings.ToLower(fields[0]) {
+		case "dscp":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("missing DSCP color in QoSFilterRule: %q", rule)
+			}
+			f.DSCP = fields[1]
+			fields = fields[2:]
+		case "metering":
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("incomplete metering in QoSFilterRule: %q", rule)
+			}
+			rate, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid metering rate in QoSFilterRule: %q", fields[1])
+			}
+			f.Metering = &QoSFilterMeter{
+				Rate:       rate,
+				ColorUnder: fields[2],
+				ColorOver:  fields[3],
+			}
+			fields = fields[4:]
+		default:
+			return nil, fmt.Errorf("unknown QoSFilterRule option: %q", fields[0])
+		}
+	}
+	if f.Action == "tag" && f.DSCP == "" {
+		return nil, fmt.Errorf("tag QoSFilterRule without DSCP: %q", rule)
+	}
+	if f.Action == "meter" && f.Metering == nil {
+		return nil, fmt.Errorf("meter QoSFilterRule without metering: %q", rule)
+	}
+	return f, nil
+}
+
+// parseQoSFilterAddr parses an address and its optional ports from
+// fields into addr, and returns the remaining fields.
+func parseQoSFilterAddr(fields []string, addr *QoSFilterAddr) ([]string, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("missing address in QoSFilterRule")
+	}
+	if fields[0] == "!" {
+		addr.Not = true
+		fields = fields[1:]
+	} else if strings.HasPrefix(fields[0], "!") {
+		addr.Not = true
+		fields[0] = fields[0][1:]
+	}
+	if len(fields) == 0 || fields[0] == "" {
+		return nil, fmt.Errorf("missing address in QoSFilterRule")
+	}
+	addr.Addr = fields[0]
+	fields = fields[1:]
+	if len(fields) > 0 && isQoSFilterPorts(fields[0]) {
+		addr.Ports = strings.Split(fields[0], ",")
+		fields = fields[1:]
+	}
+	return fields, nil
+}
+
+// isQoSFilterPorts reports whether s is a list of ports or port ranges.
+func isQoSFilterPorts(s string) bool {
+	for _, p := range strings.Split(s, ",") {
+		for _, n := range strings.SplitN(p, "-", 2) {
+			if _, err := strconv.ParseUint(n, 10, 16); err != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// String returns the text form of the filter, which can be used as
+// a QoSFilterRule.
+func (f *QoSFilter) String() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s %s %s from %s to %s", f.Action, f.Direction, f.Proto, f.Src, f.Dst)
+	if f.DSCP != "" {
+		fmt.Fprintf(&b, " DSCP %s", f.DSCP)
+	}
+	if m := f.Metering; m != nil {
+		fmt.Fprintf(&b, " metering %d %s %s", m.Rate, m.ColorUnder, m.ColorOver)
+	}
+	return b.String()
+}
+
+// String returns the text form of the address and its ports.
+func (a QoSFilterAddr) String() string {
+	s := a.Addr
+	if a.Not {
+		s = "!" + s
+	}
+	if len(a.Ports) > 0 {
+		s += " " + strings.Join(a.Ports, ",")
+	}
+	return s
+}
@@ -0,0 +1,88 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestQoSFilterRule(t *testing.T) {
+	s := QoSFilterRule("hello")
+	b := []byte{0x68, 0x65, 0x6c, 0x6c, 0x6f}
+	if v := s.Serialize(); !bytes.Equal(v, b) {
+		t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+	}
+	if s.Len() != 5 {
+		t.Fatalf("Unexpected len. Want 5, have %d", s.Len())
+	}
+	if s.Padding() != 3 {
+		t.Fatalf("Unexpected padding. Want 3, have %d", s.Padding())
+	}
+	if s.Type() != QoSFilterRuleType {
+		t.Fatalf("Unexpected type. Want %d, have %d",
+			QoSFilterRuleType, s.Type())
+	}
+	if len(s.String()) == 0 {
+		t.Fatalf("Unexpected empty string")
+	}
+}
+
+func TestDecodeQoSFilterRule(t *testing.T) {
+	rule := "tag out ip from any to 10.0.0.0/8 DSCP 0x2e"
+	s, err := Decode(QoSFilterRuleType, []byte(rule))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := string(s.(QoSFilterRule)); v != rule {
+		t.Fatalf("Unexpected string. Want %q, have %q", rule, v)
+	}
+}
+
+func TestParseQoSFilter(t *testing.T) {
+	rule := QoSFilterRule("meter in 17 from !192.168.0.0/16 1000-2000,5060 to assigned metering 64000 0x0a 0x0c")
+	f, err := rule.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Action != "meter" || f.Direction != "in" || f.Proto != "17" {
+		t.Fatalf("Unexpected action, direction or proto: %+v", f)
+	}
+	if !f.Src.Not || f.Src.Addr != "192.168.0.0/16" {
+		t.Fatalf("Unexpected source: %+v", f.Src)
+	}
+	if len(f.Src.Ports) != 2 || f.Src.Ports[0] != "1000-2000" || f.Src.Ports[1] != "5060" {
+		t.Fatalf("Unexpected source ports: %v", f.Src.Ports)
+	}
+	if f.Dst.Not || f.Dst.Addr != "assigned" || len(f.Dst.Ports) != 0 {
+		t.Fatalf("Unexpected destination: %+v", f.Dst)
+	}
+	if f.Metering == nil || f.Metering.Rate != 64000 ||
+		f.Metering.ColorUnder != "0x0a" || f.Metering.ColorOver != "0x0c" {
+		t.Fatalf("Unexpected metering: %+v", f.Metering)
+	}
+	if v := f.String(); v != string(rule) {
+		t.Fatalf("Unexpected string. Want %q, have %q", rule, v)
+	}
+}
+
+func TestParseQoSFilter_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"permit in ip from any to any DSCP 0x2e",
+		"tag up ip from any to any DSCP 0x2e",
+		"tag in tcp from any to any DSCP 0x2e",
+		"tag in ip to any from any DSCP 0x2e",
+		"tag in ip from any any DSCP 0x2e",
+		"tag in ip from any to any",
+		"meter in ip from any to any DSCP 0x2e",
+		"meter in ip from any to any metering fast 0x0a 0x0c",
+		"tag in ip from any to any DSCP 0x2e frag",
+	} {
+		if _, err := ParseQoSFilter(rule); err == nil {
+			t.Fatalf("Unexpected success parsing %q", rule)
+		}
+	}
+}
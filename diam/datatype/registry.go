@@ -0,0 +1,52 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"fmt"
+	"sync"
+)
+
+var registryMu sync.Mutex // serializes Register calls
+
+// Register adds a custom data type, such as a vendor-specific format,
+// to the Available and Decoder maps and returns its TypeID. Dictionary
+// AVPs with the given type name are then decoded by decode, and values
+// returned by decode must report the returned TypeID in their Type
+// method.
+//
+// Register must be called before loading dictionaries that use the
+// data type and before decoding any message, typically from an init
+// function, because Available and Decoder are read without locking.
+func Register(name string, decode DecoderFunc) (TypeID, error) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if name == "" || decode == nil {
+		return UnknownType, fmt.Errorf("Invalid data type registration: %q", name)
+	}
+	if _, exists := Available[name]; exists {
+		return UnknownType, fmt.Errorf("Data type already registered: %s", name)
+	}
+	id := UnknownType
+	for _, v := range Available {
+		if v > id {
+			id = v
+		}
+	}
+	id++
+	Available[name] = id
+	Decoder[id] = decode
+	return id, nil
+}
+
+// MustRegister is like Register but panics if the data type cannot be
+// registered.
+func MustRegister(name string, decode DecoderFunc) TypeID {
+	id, err := Register(name, decode)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
@@ -0,0 +1,37 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import "testing"
+
+func TestRegister(t *testing.T) {
+	id, err := Register("TestString", DecodeOctetString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		delete(Available, "TestString")
+		delete(Decoder, id)
+	}()
+	if id <= QoSFilterRuleType {
+		t.Fatalf("Unexpected TypeID %d conflicts with built-in types", id)
+	}
+	if Available["TestString"] != id {
+		t.Fatalf("Unexpected TypeID. Want %d, have %d", id, Available["TestString"])
+	}
+	v, err := Decode(id, []byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.(OctetString) != "hello" {
+		t.Fatalf("Unexpected value. Want hello, have %s", v)
+	}
+	if _, err = Register("TestString", DecodeOctetString); err == nil {
+		t.Fatal("Unexpected success registering a duplicate data type")
+	}
+	if _, err = Register("OctetString", DecodeOctetString); err == nil {
+		t.Fatal("Unexpected success registering a built-in data type")
+	}
+}
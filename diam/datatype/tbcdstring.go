@@ -0,0 +1,101 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TBCDString data type.
+//
+// TBCDString holds a string of telephony digits, such as an IMSI or
+// MSISDN, that is encoded as Telephony Binary Coded Decimal: two digits
+// per octet, the first one in the low nibble, with the high nibble of
+// the last octet set to the 0xF filler when the number of digits is odd.
+// Valid digits are 0-9, '*', '#', 'a', 'b' and 'c'. Other characters
+// are encoded as filler; use ParseTBCDString to validate values.
+// See 3GPP TS 29.002 section 17.7.8.
+type TBCDString string
+
+// tbcdDigits maps nibble values to TBCD digits.
+const tbcdDigits = "0123456789*#abc"
+
+// tbcdFiller is the nibble value used to fill odd length strings.
+const tbcdFiller = 0xf
+
+// ParseTBCDString returns s as a TBCDString, or an error if s contains
+// characters that are not valid TBCD digits.
+func ParseTBCDString(s string) (TBCDString, error) {
+	for n, c := range s {
+		if c > 0x7f || strings.IndexByte(tbcdDigits, byte(c)) < 0 {
+			return "", fmt.Errorf("Invalid TBCD digit %q at offset %d", c, n)
+		}
+	}
+	return TBCDString(s), nil
+}
+
+// DecodeTBCDString decodes a TBCDString data type from byte array.
+func DecodeTBCDString(b []byte) (Type, error) {
+	s := make([]byte, 0, len(b)*2)
+	for n, v := range b {
+		lo, hi := v&0x0f, v>>4
+		if lo == tbcdFiller {
+			return nil, fmt.Errorf("Invalid TBCD filler at offset %d", n)
+		}
+		s = append(s, tbcdDigits[lo])
+		if hi == tbcdFiller {
+			if n != len(b)-1 {
+				return nil, fmt.Errorf("Invalid TBCD filler at offset %d", n)
+			}
+			break
+		}
+		s = append(s, tbcdDigits[hi])
+	}
+	return TBCDString(s), nil
+}
+
+// Serialize implements the Type interface.
+func (s TBCDString) Serialize() []byte {
+	b := make([]byte, s.Len())
+	for n := range b {
+		lo, hi := tbcdNibble(s, 2*n), tbcdNibble(s, 2*n+1)
+		b[n] = hi<<4 | lo
+	}
+	return b
+}
+
+// tbcdNibble returns the nibble value of the digit at position n of s,
+// or the filler for invalid digits and positions past the end of s.
+func tbcdNibble(s TBCDString, n int) byte {
+	if n >= len(s) {
+		return tbcdFiller
+	}
+	if v := strings.IndexByte(tbcdDigits, s[n]); v >= 0 {
+		return byte(v)
+	}
+	return tbcdFiller
+}
+
+// Len implements the Type interface.
+func (s TBCDString) Len() int {
+	return (len(s) + 1) / 2
+}
+
+// Padding implements the Type interface.
+func (s TBCDString) Padding() int {
+	l := s.Len()
+	return pad4(l) - l
+}
+
+// Type implements the Type interface.
+func (s TBCDString) Type() TypeID {
+	return TBCDStringType
+}
+
+// String implements the Type interface.
+func (s TBCDString) String() string {
+	return fmt.Sprintf("TBCDString{%s},Padding:%d", string(s), s.Padding())
+}
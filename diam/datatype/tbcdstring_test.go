@@ -0,0 +1,61 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTBCDString(t *testing.T) {
+	s := TBCDString("123456789")
+	b := []byte{0x21, 0x43, 0x65, 0x87, 0xf9}
+	if v := s.Serialize(); !bytes.Equal(v, b) {
+		t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+	}
+	if s.Len() != 5 {
+		t.Fatalf("Unexpected len. Want 5, have %d", s.Len())
+	}
+	if s.Padding() != 3 {
+		t.Fatalf("Unexpected padding. Want 3, have %d", s.Padding())
+	}
+	if s.Type() != TBCDStringType {
+		t.Fatalf("Unexpected type. Want %d, have %d",
+			TBCDStringType, s.Type())
+	}
+	if len(s.String()) == 0 {
+		t.Fatalf("Unexpected empty string")
+	}
+}
+
+func TestDecodeTBCDString(t *testing.T) {
+	b := []byte{0x10, 0x32, 0x54, 0xba}
+	s, err := DecodeTBCDString(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := string(s.(TBCDString)); v != "012345*#" {
+		t.Fatalf("Unexpected value. Want 012345*#, have %s", v)
+	}
+	if s.Len() != 4 {
+		t.Fatalf("Unexpected len. Want 4, have %d", s.Len())
+	}
+	for _, b := range [][]byte{{0x1f}, {0xf1, 0x32}} {
+		if _, err = DecodeTBCDString(b); err == nil {
+			t.Fatalf("Unexpected success decoding 0x%x", b)
+		}
+	}
+}
+
+func TestParseTBCDString(t *testing.T) {
+	if _, err := ParseTBCDString("001010123456789"); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"12f4", "+123", "１２"} {
+		if _, err := ParseTBCDString(s); err == nil {
+			t.Fatalf("Unexpected success parsing %q", s)
+		}
+	}
+}
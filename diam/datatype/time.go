@@ -11,19 +11,82 @@ import (
 )
 
 // Time data type.
+//
+// Time is encoded as the number of seconds since 1900-01-01T00:00:00Z,
+// the 32 most significant bits of an NTP timestamp. Fractions of seconds
+// are not encoded, and are truncated. Being a 32-bit number, it wraps
+// around at 2036-02-07T06:28:16Z, the beginning of NTP era 1. Values are
+// mapped to a time according to DecodeOptions.TimeEra, by default following
+// RFC 4330 section 3, which extends the range up to 2104.
+// See RFC 6733 section 4.3.1 and RFC 5905 section 6.
 type Time time.Time
 
 const rfc868offset = 2208988800 // Diff. between 1970 and 1900 in seconds.
 
-// DecodeTime decodes a Time data type from byte array.
+// ntpEra is the number of seconds in an NTP era.
+const ntpEra = 1 << 32
+
+// EraMapping is a policy for mapping decoded Time values to NTP eras.
+type EraMapping int
+
+// Era mapping policies.
+const (
+	// EraRFC4330 maps values with the most significant bit set to
+	// era 0, from 1968-01-20T03:14:08Z to 2036-02-07T06:28:15Z, and
+	// values with the most significant bit clear to era 1, from
+	// 2036-02-07T06:28:16Z to 2104-02-26T09:42:23Z.
+	EraRFC4330 EraMapping = iota
+
+	// Era0 maps all values to era 0, from 1900-01-01T00:00:00Z to
+	// 2036-02-07T06:28:15Z.
+	Era0
+)
+
+// NewTime returns t as a Time, or an error if t is out of the range
+// that can be encoded and decoded back with the default EraRFC4330
+// mapping. Fractions of seconds are truncated.
+func NewTime(t time.Time) (Time, error) {
+	return NewTimeEra(t, EraRFC4330)
+}
+
+// NewTimeEra is like NewTime, for peers that decode Time values with
+// the era mapping m.
+func NewTimeEra(t time.Time, m EraMapping) (Time, error) {
+	secs := t.Unix() + rfc868offset
+	min, max := int64(0), int64(ntpEra-1)
+	if m == EraRFC4330 {
+		min, max = ntpEra/2, ntpEra+ntpEra/2-1
+	}
+	if secs < min || secs > max {
+		return Time{}, fmt.Errorf("Time out of range: %s", t.UTC().Format(time.RFC3339))
+	}
+	return Time(t.Truncate(time.Second)), nil
+}
+
+// DecodeTime decodes a Time data type from byte array, with the
+// EraRFC4330 mapping.
 func DecodeTime(b []byte) (Type, error) {
-	return Time(time.Unix(int64(binary.BigEndian.Uint32(b))-rfc868offset, 0)), nil
+	return decodeTime(b, EraRFC4330)
+}
+
+// decodeTime decodes a Time data type from byte array, with the era
+// mapping m.
+func decodeTime(b []byte, m EraMapping) (Type, error) {
+	if err := checkLength(b, 4, "Time"); err != nil {
+		return nil, err
+	}
+	secs := int64(binary.BigEndian.Uint32(b))
+	if m == EraRFC4330 && secs&0x80000000 == 0 {
+		secs += ntpEra
+	}
+	return Time(time.Unix(secs-rfc868offset, 0)), nil
 }
 
 // Serialize implements the Type interface.
 func (t Time) Serialize() []byte {
 	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, uint32(time.Time(t).Unix())+rfc868offset)
+	secs := time.Time(t).Unix() + rfc868offset
+	binary.BigEndian.PutUint32(b, uint32(secs%ntpEra))
 	return b
 }
 
@@ -42,6 +42,56 @@ func TestDecodeTime(t *testing.T) {
 	}
 }
 
+func TestTimeEra(t *testing.T) {
+	for _, test := range []struct {
+		Time  string
+		Bytes []byte
+	}{
+		{"1968-01-20T03:14:08Z", []byte{0x80, 0x00, 0x00, 0x00}},
+		{"2036-02-07T06:28:15Z", []byte{0xff, 0xff, 0xff, 0xff}},
+		{"2036-02-07T06:28:16Z", []byte{0x00, 0x00, 0x00, 0x00}},
+		{"2036-02-07T06:28:17Z", []byte{0x00, 0x00, 0x00, 0x01}},
+		{"2104-02-26T09:42:23Z", []byte{0x7f, 0xff, 0xff, 0xff}},
+	} {
+		tm, err := time.Parse(time.RFC3339, test.Time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := Time(tm).Serialize(); !bytes.Equal(v, test.Bytes) {
+			t.Fatalf("Unexpected value for %s. Want 0x%x, have 0x%x",
+				test.Time, test.Bytes, v)
+		}
+		v, err := DecodeTime(test.Bytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := time.Time(v.(Time)); !d.Equal(tm) {
+			t.Fatalf("Unexpected time for 0x%x. Want %s, have %s",
+				test.Bytes, tm, d.UTC())
+		}
+	}
+}
+
+func TestTimeEra0(t *testing.T) {
+	opts := &DecodeOptions{TimeEra: Era0}
+	v, err := opts.Decode(TimeType, []byte{0x00, 0x00, 0x00, 0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	if d := time.Time(v.(Time)); !d.Equal(want) {
+		t.Fatalf("Unexpected time. Want %s, have %s", want, d.UTC())
+	}
+}
+
+func TestTimeFraction(t *testing.T) {
+	tm := time.Unix(1377093974, 999999999)
+	b := []byte{0xd5, 0xbf, 0x47, 0xd6}
+	if v := Time(tm).Serialize(); !bytes.Equal(v, b) {
+		t.Fatalf("Unexpected value. Want 0x%x, have 0x%x", b, v)
+	}
+}
+
 func BenchmarkTime(b *testing.B) {
 	v := Time(time.Unix(1377093974, 0))
 	for n := 0; n < b.N; n++ {
@@ -55,3 +105,44 @@ func BenchmarkDecodeTime(b *testing.B) {
 		DecodeTime(v)
 	}
 }
+
+func TestNewTime(t *testing.T) {
+	now := time.Date(2015, 1, 2, 3, 4, 5, 6, time.UTC)
+	v, err := NewTime(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := now.Truncate(time.Second); !time.Time(v).Equal(want) {
+		t.Fatalf("Unexpected time. Want %s, have %s", want, time.Time(v))
+	}
+	testCases := []struct {
+		era   EraMapping
+		value time.Time
+		ok    bool
+	}{
+		{EraRFC4330, time.Date(1968, 1, 20, 3, 14, 8, 0, time.UTC), true},
+		{EraRFC4330, time.Date(1968, 1, 20, 3, 14, 7, 0, time.UTC), false},
+		{EraRFC4330, time.Date(2104, 2, 26, 9, 42, 23, 0, time.UTC), true},
+		{EraRFC4330, time.Date(2104, 2, 26, 9, 42, 24, 0, time.UTC), false},
+		{Era0, time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{Era0, time.Date(2036, 2, 7, 6, 28, 15, 0, time.UTC), true},
+		{Era0, time.Date(2036, 2, 7, 6, 28, 16, 0, time.UTC), false},
+	}
+	for _, tc := range testCases {
+		v, err := NewTimeEra(tc.value, tc.era)
+		if ok := err == nil; ok != tc.ok {
+			t.Fatalf("Unexpected result for %s with era mapping %d: %v", tc.value, tc.era, err)
+		}
+		if !tc.ok {
+			continue
+		}
+		opts := &DecodeOptions{TimeEra: tc.era}
+		d, err := opts.Decode(TimeType, v.Serialize())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !time.Time(d.(Time)).Equal(tc.value) {
+			t.Fatalf("Unexpected round trip. Want %s, have %s", tc.value, d)
+		}
+	}
+}
@@ -14,6 +14,9 @@ type Unsigned32 uint32
 
 // DecodeUnsigned32 decodes an Unsigned32 data type from byte array.
 func DecodeUnsigned32(b []byte) (Type, error) {
+	if err := checkLength(b, 4, "Unsigned32"); err != nil {
+		return nil, err
+	}
 	return Unsigned32(binary.BigEndian.Uint32(b)), nil
 }
 
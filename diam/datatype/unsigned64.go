@@ -14,6 +14,9 @@ type Unsigned64 uint64
 
 // DecodeUnsigned64 decodes an Unsigned64 data type from byte array.
 func DecodeUnsigned64(b []byte) (Type, error) {
+	if err := checkLength(b, 8, "Unsigned64"); err != nil {
+		return nil, err
+	}
 	return Unsigned64(binary.BigEndian.Uint64(b)), nil
 }
 
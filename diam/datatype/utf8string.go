@@ -4,14 +4,90 @@
 
 package datatype
 
-import "fmt"
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
 
 // UTF8String data type.
+//
+// Decoded values are validated according to DecodeOptions.UTF8, and
+// invalid UTF-8 is rejected by default.
 type UTF8String OctetString
 
-// DecodeUTF8String decodes an UTF8String data type from byte array.
+// UTF8Policy is a policy for handling invalid UTF-8 in UTF8String AVPs.
+type UTF8Policy int
+
+// UTF-8 validation policies.
+const (
+	// StrictUTF8 rejects data that is not valid UTF-8 with an
+	// ErrInvalidUTF8 error.
+	StrictUTF8 UTF8Policy = iota
+
+	// ReplaceInvalidUTF8 replaces each run of invalid bytes with
+	// the Unicode replacement character U+FFFD.
+	ReplaceInvalidUTF8
+)
+
+// NewUTF8String returns s as an UTF8String, or ErrInvalidUTF8 if s is
+// not valid UTF-8.
+func NewUTF8String(s string) (UTF8String, error) {
+	if err := UTF8String(s).Validate(); err != nil {
+		return "", err
+	}
+	return UTF8String(s), nil
+}
+
+// ErrInvalidUTF8 is returned when an UTF8String contains an invalid
+// UTF-8 sequence. Offset is the position of the first invalid byte.
+type ErrInvalidUTF8 struct {
+	Offset int
+}
+
+// Error implements the error interface.
+func (e ErrInvalidUTF8) Error() string {
+	return fmt.Sprintf("Invalid UTF-8 sequence in UTF8String at offset %d", e.Offset)
+}
+
+// DecodeUTF8String decodes an UTF8String data type from byte array,
+// and returns ErrInvalidUTF8 if it is not valid UTF-8.
 func DecodeUTF8String(b []byte) (Type, error) {
-	return UTF8String(OctetString(b)), nil
+	return decodeUTF8String(b, StrictUTF8)
+}
+
+// decodeUTF8String decodes an UTF8String data type from byte array,
+// handling invalid UTF-8 according to the policy p.
+func decodeUTF8String(b []byte, p UTF8Policy) (Type, error) {
+	if utf8.Valid(b) {
+		return UTF8String(OctetString(b)), nil
+	}
+	if p == ReplaceInvalidUTF8 {
+		return UTF8String(bytes.ToValidUTF8(b, []byte("\uFFFD"))), nil
+	}
+	return nil, ErrInvalidUTF8{Offset: invalidUTF8Offset(b)}
+}
+
+// Validate returns ErrInvalidUTF8 if s is not valid UTF-8. It can be
+// used to check values before they are added to messages.
+func (s UTF8String) Validate() error {
+	if utf8.ValidString(string(s)) {
+		return nil
+	}
+	return ErrInvalidUTF8{Offset: invalidUTF8Offset([]byte(s))}
+}
+
+// invalidUTF8Offset returns the offset of the first invalid UTF-8
+// sequence in b, or -1 if b is valid.
+func invalidUTF8Offset(b []byte) int {
+	for n := 0; n < len(b); {
+		r, size := utf8.DecodeRune(b[n:])
+		if r == utf8.RuneError && size == 1 {
+			return n
+		}
+		n += size
+	}
+	return -1
 }
 
 // Serialize implements the Type interface.
@@ -69,3 +69,41 @@ func BenchmarkDecodeUTF8String(b *testing.B) {
 		DecodeUTF8String(v)
 	}
 }
+
+func TestDecodeUTF8StringInvalid(t *testing.T) {
+	b := []byte{0x68, 0xc3, 0xa9, 0xff, 0x6f}
+	_, err := DecodeUTF8String(b)
+	e, ok := err.(ErrInvalidUTF8)
+	if !ok {
+		t.Fatalf("Unexpected error. Want ErrInvalidUTF8, have %v", err)
+	}
+	if e.Offset != 3 {
+		t.Fatalf("Unexpected offset. Want 3, have %d", e.Offset)
+	}
+	if err = UTF8String(b).Validate(); err != e {
+		t.Fatalf("Unexpected error. Want %v, have %v", e, err)
+	}
+	if err = UTF8String("h\u00e9llo").Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecodeUTF8StringReplaceInvalid(t *testing.T) {
+	opts := &DecodeOptions{UTF8: ReplaceInvalidUTF8}
+	s, err := opts.Decode(UTF8StringType, []byte{0x68, 0xff, 0xfe, 0x6f})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "h\ufffdo"; string(s.(UTF8String)) != want {
+		t.Fatalf("Unexpected value. Want %q, have %q", want, s)
+	}
+}
+
+func TestNewUTF8String(t *testing.T) {
+	if _, err := NewUTF8String("h\u00e9llo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewUTF8String("h\xffo"); err == nil {
+		t.Fatal("Unexpected success with invalid UTF-8")
+	}
+}
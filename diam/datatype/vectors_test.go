@@ -0,0 +1,109 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package datatype
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// testVector is an entry of testdata/vectors.json.
+//
+// Hex is the encoded data, without AVP header or padding. Value is the
+// text form of the decoded data: decimal for numbers, the shortest
+// representation for floats, the address for Address and IPv4, RFC 3339
+// in UTC for Time, and the text itself for strings. Value is omitted
+// for binary data. Vectors with Error set must fail to decode.
+type testVector struct {
+	Type  string `json:"type"`
+	Hex   string `json:"hex"`
+	Value string `json:"value"`
+	Error bool   `json:"error"`
+	Note  string `json:"note"`
+}
+
+func TestVectors(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors []testVector
+	if err = json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		typ, ok := Available[v.Type]
+		if !ok {
+			t.Fatalf("Unknown type %q", v.Type)
+		}
+		b, err := hex.DecodeString(v.Hex)
+		if err != nil {
+			t.Fatalf("Invalid hex for %s %q: %s", v.Type, v.Note, err)
+		}
+		d, err := Decode(typ, b)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("%s 0x%s (%s) was decoded with no error", v.Type, v.Hex, v.Note)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed to decode %s 0x%s (%s): %s", v.Type, v.Hex, v.Note, err)
+		}
+		if v.Value != "" || v.Hex == "" {
+			if s := vectorValue(d); s != v.Value {
+				t.Fatalf("Unexpected %s value for 0x%s. Want %q, have %q",
+					v.Type, v.Hex, v.Value, s)
+			}
+		}
+		if s := d.Serialize(); !bytes.Equal(s, b) {
+			t.Fatalf("Unexpected %s serialization. Want 0x%x, have 0x%x",
+				v.Type, b, s)
+		}
+		if d.Len() != len(b) {
+			t.Fatalf("Unexpected %s len. Want %d, have %d", v.Type, len(b), d.Len())
+		}
+	}
+}
+
+// vectorValue returns the text form of d, as described in testVector.
+func vectorValue(d Type) string {
+	switch v := d.(type) {
+	case Address:
+		return net.IP(v).String()
+	case IPv4:
+		return net.IP(v).String()
+	case Time:
+		return time.Time(v).UTC().Format(time.RFC3339)
+	case Float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case Float64:
+		return strconv.FormatFloat(float64(v), 'g', -1, 64)
+	case Enumerated, Integer32, Integer64, Unsigned32, Unsigned64:
+		return fmt.Sprintf("%d", v)
+	case DiameterIdentity:
+		return string(v)
+	case DiameterURI:
+		return string(v)
+	case IPFilterRule:
+		return string(v)
+	case OctetString:
+		return string(v)
+	case QoSFilterRule:
+		return string(v)
+	case TBCDString:
+		return string(v)
+	case UTF8String:
+		return string(v)
+	}
+	return d.String()
+}
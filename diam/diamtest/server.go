@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"net"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // A Server is a Diameter server listening on a system-chosen port on the
@@ -0,0 +1,189 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Dictionary builder.  Part of go-diameter.
+
+package dict
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// Builder defines dictionaries in Go code, without writing XML.
+//
+// Example:
+//
+//	b := dict.NewBuilder()
+//	app := b.App(1000, "auth", "Example")
+//	app.Vendor(10415, "TGPP")
+//	app.Command(300, "Example", "EX").
+//		Request(&dict.Rule{AVP: "Session-Id", Required: true, Max: 1}).
+//		Answer(&dict.Rule{AVP: "Result-Code", Required: true, Max: 1})
+//	app.AVP("Example-Mode", 65000, datatype.EnumeratedType).
+//		Must("M").
+//		Enum(0, "OFF").
+//		Enum(1, "ON")
+//	if err := b.Load(dict.Default); err != nil {
+//		log.Fatal(err)
+//	}
+type Builder struct {
+	file *File
+	err  error
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{file: &File{}}
+}
+
+// App adds an application to the dictionary. The type is "auth" or
+// "acct", and may be empty for the base protocol.
+func (b *Builder) App(id uint32, typ, name string) *AppBuilder {
+	app := &App{ID: id, Type: typ, Name: name}
+	b.file.App = append(b.file.App, app)
+	return &AppBuilder{b: b, app: app}
+}
+
+// File returns the dictionary defined so far, or the first error
+// found while defining it.
+func (b *Builder) File() (*File, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.file, nil
+}
+
+// Load loads the dictionary into the Parser p. The dictionary is kept
+// in p as if loaded by p.Load, and is not affected by further changes
+// to the Builder.
+func (b *Builder) Load(p *Parser) error {
+	f, err := b.File()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err = Encode(&buf, f, XML); err != nil {
+		return err
+	}
+	return p.Load(&buf)
+}
+
+// Parser returns a new Parser with the dictionary loaded.
+func (b *Builder) Parser() (*Parser, error) {
+	p, _ := NewParser()
+	if err := b.Load(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// AppBuilder defines the contents of an application.
+type AppBuilder struct {
+	b   *Builder
+	app *App
+}
+
+// Vendor adds a vendor to the application.
+func (ab *AppBuilder) Vendor(id uint32, name string) *AppBuilder {
+	ab.app.Vendor = append(ab.app.Vendor, &Vendor{ID: id, Name: name})
+	return ab
+}
+
+// Command adds a command to the application.
+func (ab *AppBuilder) Command(code uint32, name, short string) *CommandBuilder {
+	cmd := &Command{Code: code, Name: name, Short: short}
+	ab.app.Command = append(ab.app.Command, cmd)
+	return &CommandBuilder{cmd: cmd}
+}
+
+// AVP adds an AVP to the application. The type must be one of the
+// data types available in the datatype package.
+func (ab *AppBuilder) AVP(name string, code uint32, typ datatype.TypeID) *AVPBuilder {
+	avp := &AVP{Name: name, Code: code}
+	avp.Data.TypeName = typeName(typ)
+	if avp.Data.TypeName == "" && ab.b.err == nil {
+		ab.b.err = fmt.Errorf("Unsupported data type %d for AVP %s", typ, name)
+	}
+	ab.app.AVP = append(ab.app.AVP, avp)
+	return &AVPBuilder{avp: avp}
+}
+
+// typeName returns the name of the data type id, or an empty string
+// if the type is not available.
+func typeName(id datatype.TypeID) string {
+	for name, v := range datatype.Available {
+		if v == id {
+			return name
+		}
+	}
+	return ""
+}
+
+// CommandBuilder defines the rules of a command.
+type CommandBuilder struct {
+	cmd *Command
+}
+
+// Request adds rules to the request of the command.
+func (cb *CommandBuilder) Request(rules ...*Rule) *CommandBuilder {
+	cb.cmd.Request.Rule = append(cb.cmd.Request.Rule, rules...)
+	return cb
+}
+
+// Answer adds rules to the answer of the command.
+func (cb *CommandBuilder) Answer(rules ...*Rule) *CommandBuilder {
+	cb.cmd.Answer.Rule = append(cb.cmd.Answer.Rule, rules...)
+	return cb
+}
+
+// AVPBuilder defines the flags and data of an AVP.
+type AVPBuilder struct {
+	avp *AVP
+}
+
+// Must sets the flags the AVP must have, e.g. "M" or "V,M".
+func (vb *AVPBuilder) Must(flags string) *AVPBuilder {
+	vb.avp.Must = flags
+	return vb
+}
+
+// May sets the flags the AVP may have.
+func (vb *AVPBuilder) May(flags string) *AVPBuilder {
+	vb.avp.May = flags
+	return vb
+}
+
+// MustNot sets the flags the AVP must not have.
+func (vb *AVPBuilder) MustNot(flags string) *AVPBuilder {
+	vb.avp.MustNot = flags
+	return vb
+}
+
+// VendorID sets the vendor of the AVP. It defaults to the vendor of
+// the application for AVPs that must have the 'V' bit.
+func (vb *AVPBuilder) VendorID(id uint32) *AVPBuilder {
+	vb.avp.VendorID = id
+	return vb
+}
+
+// MayEncrypt sets whether the AVP may be encrypted, "Y" or "N".
+func (vb *AVPBuilder) MayEncrypt(v string) *AVPBuilder {
+	vb.avp.MayEncrypt = v
+	return vb
+}
+
+// Enum adds an item to an Enumerated AVP.
+func (vb *AVPBuilder) Enum(code int32, name string) *AVPBuilder {
+	vb.avp.Data.Enum = append(vb.avp.Data.Enum, &Enum{Code: code, Name: name})
+	return vb
+}
+
+// Rule adds rules to a Grouped AVP.
+func (vb *AVPBuilder) Rule(rules ...*Rule) *AVPBuilder {
+	vb.avp.Data.Rule = append(vb.avp.Data.Rule, rules...)
+	return vb
+}
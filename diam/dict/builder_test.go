@@ -0,0 +1,65 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dict
+
+import (
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder()
+	app := b.App(1000, "auth", "Example")
+	app.Vendor(10415, "TGPP")
+	app.Command(300, "Example", "EX").
+		Request(&Rule{AVP: "Example-Mode", Required: true, Max: 1}).
+		Answer(&Rule{AVP: "Example-Group", Required: false, Max: 1})
+	app.AVP("Example-Mode", 65000, datatype.EnumeratedType).
+		Must("V,M").
+		MayEncrypt("N").
+		Enum(0, "OFF").
+		Enum(1, "ON")
+	app.AVP("Example-Group", 65001, datatype.GroupedType).
+		Must("M").
+		Rule(&Rule{AVP: "Example-Mode", Required: true})
+	p, err := b.Parser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	avp, err := p.FindAVP(1000, "Example-Mode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 65000 || avp.Data.Type != datatype.EnumeratedType {
+		t.Fatalf("Unexpected AVP: %#v", avp)
+	}
+	if name, err := p.EnumName(1000, 65000, 1); err != nil || name != "ON" {
+		t.Fatalf("Unexpected enum name %q: %v", name, err)
+	}
+	if _, err = p.Rule(1000, 65001, "Example-Mode"); err != nil {
+		t.Fatal(err)
+	}
+	cmd, err := p.FindCommand(1000, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Short != "EX" || len(cmd.Request.Rule) != 1 {
+		t.Fatalf("Unexpected command: %#v", cmd)
+	}
+	// Changes after loading do not affect the Parser.
+	app.AVP("Late-AVP", 65002, datatype.UTF8StringType)
+	if _, err = p.FindAVP(1000, "Late-AVP"); err == nil {
+		t.Fatal("AVP added after loading was found")
+	}
+}
+
+func TestBuilderInvalidType(t *testing.T) {
+	b := NewBuilder()
+	b.App(1000, "auth", "Example").AVP("Bad-AVP", 65000, datatype.TypeID(-1))
+	if _, err := b.Parser(); err == nil {
+		t.Fatal("Dictionary with invalid type was loaded")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+//go:build !nodefaultdict
+// +build !nodefaultdict
+
+package dict
+
+import "embed"
+
+//go:embed xml/base.xml xml/credit_control.xml xml/tgpp_ro_rf.xml
+//go:embed xml/tgpp_s6a.xml xml/tgpp_gx.xml xml/tgpp_rx.xml xml/tgpp_cx.xml
+//go:embed xml/tgpp_sh.xml xml/tgpp_swx.xml xml/tgpp_sta.xml xml/tgpp_s6b.xml
+var defaultFS embed.FS
+
+// defaultFiles are the dictionaries of Default, in load order.
+var defaultFiles = []string{
+	"base.xml",
+	"credit_control.xml",
+	"tgpp_ro_rf.xml",
+	"tgpp_s6a.xml",
+	"tgpp_gx.xml",
+	"tgpp_rx.xml",
+	"tgpp_cx.xml",
+	"tgpp_sh.xml",
+	"tgpp_swx.xml",
+	"tgpp_sta.xml",
+	"tgpp_s6b.xml",
+}
+
+// defaultDicts returns the embedded dictionaries of Default.
+func defaultDicts() [][]byte {
+	dicts := make([][]byte, 0, len(defaultFiles))
+	for _, name := range defaultFiles {
+		b, err := defaultFS.ReadFile("xml/" + name)
+		if err != nil {
+			panic(err)
+		}
+		dicts = append(dicts, b)
+	}
+	return dicts
+}
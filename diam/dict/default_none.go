@@ -0,0 +1,14 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+//go:build nodefaultdict
+// +build nodefaultdict
+
+package dict
+
+// defaultDicts returns no dictionaries: the nodefaultdict build tag
+// leaves the default dictionaries out of the binary.
+func defaultDicts() [][]byte {
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Default dictionary management.  Part of go-diameter.
+
+package dict
+
+import "sync/atomic"
+
+var defaultParser atomic.Value // *Parser
+
+// DefaultParser returns the current default dictionary Parser. It is
+// the Parser set by SetDefault, or Default if SetDefault was never
+// called. DefaultParser is safe for concurrent use.
+func DefaultParser() *Parser {
+	if p, ok := defaultParser.Load().(*Parser); ok && p != nil {
+		return p
+	}
+	return Default
+}
+
+// SetDefault atomically replaces the default dictionary Parser used
+// by messages and connections that have no dictionary of their own.
+// Passing nil restores the pre-loaded Default dictionary.
+//
+// SetDefault allows test suites and processes running multiple stacks
+// to use isolated dictionaries instead of loading their definitions
+// into the shared Default Parser.
+func SetDefault(p *Parser) {
+	defaultParser.Store(p)
+}
@@ -0,0 +1,79 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dict
+
+import (
+	"io/ioutil"
+	"sync"
+	"testing"
+)
+
+func TestSetDefault(t *testing.T) {
+	if DefaultParser() != Default {
+		t.Fatal("DefaultParser is not Default")
+	}
+	p, err := NewParser(testDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDefault(p)
+	if DefaultParser() != p {
+		t.Fatal("DefaultParser was not replaced")
+	}
+	SetDefault(nil)
+	if DefaultParser() != Default {
+		t.Fatal("DefaultParser was not restored")
+	}
+}
+
+func TestSetDefaultConcurrent(t *testing.T) {
+	defer SetDefault(nil)
+	p, err := NewParser(testDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			SetDefault(p)
+		}()
+		go func() {
+			defer wg.Done()
+			if DefaultParser() == nil {
+				t.Error("DefaultParser returned nil")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestParserBoot(t *testing.T) {
+	b, err := ioutil.ReadFile(testDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	p := &Parser{init: func() [][]byte {
+		n++
+		return [][]byte{b}
+	}}
+	if n != 0 {
+		t.Fatal("Dictionaries were loaded before first use")
+	}
+	if _, err = p.FindCommand(0, 257); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = p.FindCommand(0, 257); err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("Unexpected # of loads. Want 1, have %d", n)
+	}
+}
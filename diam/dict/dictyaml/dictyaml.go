@@ -0,0 +1,59 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package dictyaml adds support for YAML dictionaries to package dict.
+//
+// Importing it registers the YAML format, so that dict.Parser.Load
+// and dict.Decode accept YAML dictionaries:
+//
+//	import _ "github.com/fiorix/go-diameter/v2/diam/dict/dictyaml"
+//
+// The YAML document has the same structure as the JSON one, with the
+// keys of the yaml struct tags of dict.File. It lives in its own
+// package so that programs that do not use YAML don't depend on a
+// YAML library.
+package dictyaml
+
+import (
+	"bytes"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// Format is the name of the YAML dictionary format.
+const Format dict.Format = "yaml"
+
+func init() {
+	dict.RegisterFormat(Format, Detect, Decode)
+}
+
+// Detect reports whether the dictionary b is in YAML. Documents that
+// start with '<' or '{' are left to the XML and JSON formats.
+func Detect(b []byte) bool {
+	b = bytes.TrimSpace(b)
+	return len(b) > 0 && b[0] != '<' && b[0] != '{'
+}
+
+// Decode decodes the YAML dictionary b.
+func Decode(b []byte) (*dict.File, error) {
+	f := new(dict.File)
+	if err := yaml.Unmarshal(b, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Encode writes the dictionary f to w in YAML. Along with dict.Decode
+// it converts dictionaries from XML or JSON to YAML.
+func Encode(w io.Writer, f *dict.File) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(f); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,84 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dictyaml
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+const testYAMLDict = `
+application:
+  - id: 1000
+    avp:
+      - name: Test-AVP
+        code: 65000
+        must: M
+        data:
+          type: Unsigned32
+`
+
+func TestLoadYAML(t *testing.T) {
+	if f := dict.DetectFormat([]byte(testYAMLDict)); f != Format {
+		t.Fatalf("Unexpected format. Want %q, have %q", Format, f)
+	}
+	p, _ := dict.NewParser()
+	if err := p.Load(strings.NewReader(testYAMLDict)); err != nil {
+		t.Fatal(err)
+	}
+	avp, err := p.FindAVP(1000, "Test-AVP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 65000 {
+		t.Fatalf("Unexpected code. Want 65000, have %d", avp.Code)
+	}
+	if avp.Data.Type != datatype.Unsigned32Type {
+		t.Fatalf("Unexpected type. Want %d, have %d",
+			datatype.Unsigned32Type, avp.Data.Type)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	for _, b := range []string{"<diameter/>", " {}", ""} {
+		if Detect([]byte(b)) {
+			t.Fatalf("Unexpected YAML format for %q", b)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	b, err := os.ReadFile("../xml/credit_control.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := dict.Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var y bytes.Buffer
+	if err = Encode(&y, f); err != nil {
+		t.Fatal(err)
+	}
+	p, _ := dict.NewParser()
+	if err = p.Load(&y); err != nil {
+		t.Fatal(err)
+	}
+	avp, err := p.FindAVP(4, "CC-Request-Type")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(avp.Data.Enum) != 4 {
+		t.Fatalf("Unexpected # of enum items. Want 4, have %d", len(avp.Data.Enum))
+	}
+	if _, err = Decode([]byte("application: [")); err == nil {
+		t.Fatal("Invalid YAML was decoded")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Dictionary formats.  Part of go-diameter.
+
+package dict
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Format is the name of a dictionary file format.
+type Format string
+
+// Built-in dictionary formats.
+const (
+	XML  Format = "xml"
+	JSON Format = "json"
+)
+
+// FormatDecoder decodes a dictionary into a File.
+type FormatDecoder func(b []byte) (*File, error)
+
+type format struct {
+	name   Format
+	detect func(b []byte) bool
+	decode FormatDecoder
+}
+
+var formats = struct {
+	sync.RWMutex
+	list []format
+}{}
+
+// RegisterFormat registers a dictionary format to be loaded by the
+// Parser. The detect function reports whether a dictionary is in this
+// format, and is called before the built-in formats are tried. Formats
+// are tried in the order they're registered.
+//
+// The YAML format is registered by importing package dictyaml.
+func RegisterFormat(name Format, detect func(b []byte) bool, decode FormatDecoder) {
+	formats.Lock()
+	defer formats.Unlock()
+	formats.list = append(formats.list, format{name, detect, decode})
+}
+
+// DetectFormat returns the format of the dictionary b. Registered
+// formats are tried first, then JSON for documents starting with '{'.
+// Anything else is assumed to be XML.
+func DetectFormat(b []byte) Format {
+	if f, ok := findFormat(b); ok {
+		return f.name
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		return JSON
+	}
+	return XML
+}
+
+func findFormat(b []byte) (format, bool) {
+	formats.RLock()
+	defer formats.RUnlock()
+	for _, f := range formats.list {
+		if f.detect(b) {
+			return f, true
+		}
+	}
+	return format{}, false
+}
+
+// Decode decodes the dictionary b, in any of the supported formats.
+func Decode(b []byte) (*File, error) {
+	if f, ok := findFormat(b); ok {
+		file, err := f.decode(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", f.name, err)
+		}
+		return file, nil
+	}
+	file := new(File)
+	var err error
+	switch DetectFormat(b) {
+	case JSON:
+		err = json.Unmarshal(b, file)
+	default:
+		err = xml.Unmarshal(b, file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Encode writes the dictionary f to w in the given format, which must
+// be XML or JSON. It can be used along with Decode to convert
+// dictionaries from one format to another. See dictyaml.Encode for
+// YAML.
+func Encode(w io.Writer, f *File, format Format) error {
+	var (
+		b   []byte
+		err error
+	)
+	switch format {
+	case XML:
+		b, err = xml.MarshalIndent(f, "", "\t")
+		if err == nil {
+			b = append([]byte(xml.Header), b...)
+		}
+	case JSON:
+		b, err = json.MarshalIndent(f, "", "\t")
+	default:
+		return fmt.Errorf("Unsupported dictionary format: %s", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// Convert reads a dictionary in any of the supported formats from r
+// and writes it to w in the given format.
+func Convert(w io.Writer, r io.Reader, format Format) error {
+	var b bytes.Buffer
+	if _, err := b.ReadFrom(r); err != nil {
+		return err
+	}
+	f, err := Decode(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return Encode(w, f, format)
+}
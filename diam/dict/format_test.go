@@ -0,0 +1,117 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dict
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+const testJSONDict = `{
+	"application": [{
+		"id": 1000,
+		"avp": [{
+			"name": "Test-AVP",
+			"code": 65000,
+			"must": "M",
+			"data": {"type": "Unsigned32"}
+		}]
+	}]
+}`
+
+func TestLoadJSON(t *testing.T) {
+	if f := DetectFormat([]byte(testJSONDict)); f != JSON {
+		t.Fatalf("Unexpected format. Want %q, have %q", JSON, f)
+	}
+	p, _ := NewParser()
+	if err := p.Load(strings.NewReader(testJSONDict)); err != nil {
+		t.Fatal(err)
+	}
+	avp, err := p.FindAVP(1000, "Test-AVP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 65000 {
+		t.Fatalf("Unexpected code. Want 65000, have %d", avp.Code)
+	}
+	if avp.Data.Type != datatype.Unsigned32Type {
+		t.Fatalf("Unexpected type. Want %d, have %d",
+			datatype.Unsigned32Type, avp.Data.Type)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	f, err := os.Open(testDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var js bytes.Buffer
+	if err = Convert(&js, f, JSON); err != nil {
+		t.Fatal(err)
+	}
+	var x bytes.Buffer
+	if err = Convert(&x, bytes.NewReader(js.Bytes()), XML); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := NewParser(testDict)
+	for _, b := range [][]byte{js.Bytes(), x.Bytes()} {
+		p, _ := NewParser()
+		if err = p.Load(bytes.NewReader(b)); err != nil {
+			t.Fatal(err)
+		}
+		if p.String() != want.String() {
+			t.Fatalf("Unexpected dictionary after conversion:\n%s", b)
+		}
+	}
+}
+
+func TestEncodeUnsupported(t *testing.T) {
+	var b bytes.Buffer
+	if err := Encode(&b, &File{}, "yaml"); err == nil {
+		t.Fatal("Unsupported format was encoded")
+	}
+}
+
+func TestRegisterFormat(t *testing.T) {
+	// A format with one AVP per line: "appid name code type".
+	const prefix = "#avps\n"
+	RegisterFormat("avps", func(b []byte) bool {
+		return bytes.HasPrefix(b, []byte(prefix))
+	}, func(b []byte) (*File, error) {
+		app := &App{ID: 1000}
+		lines := strings.Split(strings.TrimPrefix(string(b), prefix), "\n")
+		for _, line := range lines {
+			var avp AVP
+			var appid uint32
+			_, err := fmt.Sscan(line, &appid, &avp.Name, &avp.Code, &avp.Data.TypeName)
+			if err != nil {
+				return nil, err
+			}
+			app.AVP = append(app.AVP, &avp)
+		}
+		return &File{App: []*App{app}}, nil
+	})
+	dict := prefix + "1000 Test-AVP 65000 UTF8String"
+	if f := DetectFormat([]byte(dict)); f != "avps" {
+		t.Fatalf("Unexpected format. Want \"avps\", have %q", f)
+	}
+	p, _ := NewParser()
+	if err := p.Load(strings.NewReader(dict)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.FindAVP(1000, 65000); err != nil {
+		t.Fatal(err)
+	}
+	err := p.Load(strings.NewReader(prefix + "1000 Bad-AVP"))
+	if err == nil || !strings.HasPrefix(err.Error(), "avps: ") {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
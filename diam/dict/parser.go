@@ -8,32 +8,52 @@ package dict
 
 import (
 	"bytes"
-	"encoding/xml"
 	"fmt"
 	"io"
-	"os"
+	"io/ioutil"
 	"sync"
+	"sync/atomic"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
 )
 
-// Parser is the root element for dictionaries and supports multiple XML
-// dictionary files loaded together. Diameter applications use dictionaries
+// Parser is the root element for dictionaries and supports multiple
+// dictionary files loaded together, in XML, JSON or any format added
+// by RegisterFormat. Diameter applications use dictionaries
 // to parse messages received from peers as well as to encode crafted
 // messages before sending them over the wire.
 //
-// Parser can load multiple XML dictionary files, which in turn support
+// Parser can load multiple dictionary files, which in turn support
 // multiple applications that are composed by multiple AVPs.
 //
 // The Parser element has an index to make pre-loaded AVPs searcheable per App.
+// The index is replaced atomically by Load and Reload, so a Parser is
+// safe for concurrent use, and lookups that are in progress keep using
+// the index they started with.
 type Parser struct {
+	mu     sync.Mutex   // serializes Load and Reload, and protects source
+	idx    atomic.Value // *index
+	source []*source    // dictionaries loaded, in order
+
+	once sync.Once       // runs init
+	init func() [][]byte // dictionaries loaded on first use, or nil
+}
+
+// index holds the dictionaries loaded in a Parser, and indexes them.
+// An index is never modified after being stored in a Parser.
+type index struct {
 	file    []*File              // Dict supports multiple XML dictionaries
 	appcode map[uint32]*App      // Application index by code
 	avpname map[nameIdx]*AVP     // AVP index by name
-	avpcode map[codeIdx]*AVP     // AVP index by code
+	avpcode map[codeIdx][]*AVP   // AVP index by code, one per vendor
 	command map[codeIdx]*Command // Command index
-	mu      sync.Mutex           // Protects all maps
-	once    sync.Once
+}
+
+// source is a dictionary loaded in a Parser. Dictionaries loaded from
+// files are read again by Reload, while others are kept in memory.
+type source struct {
+	filename string
+	data     []byte
 }
 
 type codeIdx struct {
@@ -41,11 +61,91 @@ type codeIdx struct {
 	code  uint32
 }
 
+// vendorIdx identifies an AVP, which codes are only unique within a
+// vendor.
+type vendorIdx struct {
+	appID  uint32
+	code   uint32
+	vendor uint32
+}
+
 type nameIdx struct {
 	appID uint32
 	name  string
 }
 
+func newIndex() *index {
+	return &index{
+		appcode: make(map[uint32]*App),
+		avpname: make(map[nameIdx]*AVP),
+		avpcode: make(map[codeIdx][]*AVP),
+		command: make(map[codeIdx]*Command),
+	}
+}
+
+// clone returns a copy of the index that can be modified.
+func (idx *index) clone() *index {
+	c := newIndex()
+	c.file = append(c.file, idx.file...)
+	for k, v := range idx.appcode {
+		c.appcode[k] = v
+	}
+	for k, v := range idx.avpname {
+		c.avpname[k] = v
+	}
+	for k, v := range idx.avpcode {
+		c.avpcode[k] = v
+	}
+	for k, v := range idx.command {
+		c.command[k] = v
+	}
+	return c
+}
+
+// add decodes a dictionary and adds it to the index.
+func (idx *index) add(b []byte) error {
+	f, err := Decode(b)
+	if err != nil {
+		return err
+	}
+	idx.file = append(idx.file, f)
+	for _, app := range f.App {
+		// Cache supported applications by ID.
+		idx.appcode[app.ID] = app
+		// Cache commands.
+		for _, cmd := range app.Command {
+			idx.command[codeIdx{app.ID, cmd.Code}] = cmd
+		}
+		// Cache AVPs.
+		for _, avp := range app.AVP {
+			// Link AVP to its Application
+			avp.App = app
+			avp.VendorID = app.AVPVendorID(avp)
+			idx.avpname[nameIdx{app.ID, avp.Name}] = avp
+			k := codeIdx{app.ID, avp.Code}
+			idx.avpcode[k] = addAVP(idx.avpcode[k], avp)
+			// Check the AVP type.
+			if err := updateType(avp); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addAVP returns a copy of list with avp added last, replacing any
+// AVP of the same vendor. list is not modified, as it may be shared
+// with a previous index.
+func addAVP(list []*AVP, avp *AVP) []*AVP {
+	l := make([]*AVP, 0, len(list)+1)
+	for _, v := range list {
+		if v.VendorID != avp.VendorID {
+			l = append(l, v)
+		}
+	}
+	return append(l, avp)
+}
+
 // NewParser allocates a new Parser optionally loading dictionary XML files.
 func NewParser(filename ...string) (*Parser, error) {
 	p := new(Parser)
@@ -58,54 +158,164 @@ func NewParser(filename ...string) (*Parser, error) {
 	return p, nil
 }
 
-// LoadFile loads a dictionary XML file. May be used multiple times.
+// boot loads the dictionaries returned by p.init, once, before the
+// Parser is first used, or modified. The dictionaries are built into
+// the package, so failing to load them is a bug.
+func (p *Parser) boot() {
+	if p.init == nil {
+		return
+	}
+	p.once.Do(func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		idx := newIndex()
+		for _, b := range p.init() {
+			if err := idx.add(b); err != nil {
+				panic(err)
+			}
+			p.source = append(p.source, &source{data: b})
+		}
+		p.idx.Store(idx)
+	})
+}
+
+// index returns the current index of the Parser.
+func (p *Parser) index() *index {
+	p.boot()
+	if idx, ok := p.idx.Load().(*index); ok {
+		return idx
+	}
+	return newIndex()
+}
+
+// LoadFile loads a dictionary file. May be used multiple times.
+// The file is read again by Reload.
 func (p *Parser) LoadFile(filename string) error {
-	fd, err := os.Open(filename)
+	b, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return err
 	}
-	defer fd.Close()
-	return p.Load(fd)
+	return p.load(&source{filename: filename}, b)
 }
 
 // Load loads a dictionary from byte array. May be used multiple times.
+// The dictionary is kept in memory to be loaded again by Reload.
 func (p *Parser) Load(r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return p.load(&source{data: b}, b)
+}
+
+// load adds the dictionary b, read from src, to the Parser. The Parser
+// is not modified if b cannot be loaded.
+func (p *Parser) load(src *source, b []byte) error {
+	p.boot()
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	p.once.Do(func() {
-		p.appcode = make(map[uint32]*App)
-		p.avpname = make(map[nameIdx]*AVP)
-		p.avpcode = make(map[codeIdx]*AVP)
-		p.command = make(map[codeIdx]*Command)
-	})
-	f := new(File)
-	d := xml.NewDecoder(r)
-	if err := d.Decode(f); err != nil {
+	idx := p.index().clone()
+	if err := idx.add(b); err != nil {
 		return err
 	}
-	p.file = append(p.file, f)
-	for _, app := range f.App {
-		// Cache supported applications by ID.
-		p.appcode[app.ID] = app
-		// Cache commands.
-		for _, cmd := range app.Command {
-			p.command[codeIdx{app.ID, cmd.Code}] = cmd
-		}
-		// Cache AVPs.
-		for _, avp := range app.AVP {
-			// Link AVP to its Application
-			avp.App = app
-			p.avpname[nameIdx{app.ID, avp.Name}] = avp
-			p.avpcode[codeIdx{app.ID, avp.Code}] = avp
-			// Check the AVP type.
-			if err := updateType(avp); err != nil {
+	p.source = append(p.source, src)
+	p.idx.Store(idx)
+	return nil
+}
+
+// Reload loads all dictionaries of the Parser again, reading the ones
+// loaded by LoadFile from disk, and atomically replaces them. It can be
+// used to apply changes to dictionary files without restarting, for
+// example on SIGHUP. If any dictionary fails to load, the Parser is not
+// modified and the error is returned.
+//
+// Messages decoded concurrently with Reload use either the old or the
+// new dictionaries. Objects returned by the Parser before Reload, such
+// as *AVP, are not modified.
+func (p *Parser) Reload() error {
+	p.boot()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	idx := newIndex()
+	for _, src := range p.source {
+		b := src.data
+		if src.filename != "" {
+			var err error
+			if b, err = ioutil.ReadFile(src.filename); err != nil {
 				return err
 			}
 		}
+		if err := idx.add(b); err != nil {
+			if src.filename != "" {
+				return fmt.Errorf("%s: %s", src.filename, err)
+			}
+			return err
+		}
 	}
+	p.idx.Store(idx)
 	return nil
 }
 
+// Dump writes the dictionaries loaded in the Parser to w, merged into
+// a single dictionary in the given format, XML or JSON. Applications
+// loaded from multiple files are merged, and commands and AVPs that
+// were loaded more than once are written as last loaded, which is how
+// the Parser uses them.
+func (p *Parser) Dump(w io.Writer, format Format) error {
+	return Encode(w, p.index().merge(), format)
+}
+
+// merge returns the dictionaries of the index merged into one File.
+func (idx *index) merge() *File {
+	f := new(File)
+	apps := make(map[uint32]*App)
+	vendors := make(map[codeIdx]bool)
+	commands := make(map[codeIdx]int)
+	avps := make(map[vendorIdx]int)
+	for _, file := range idx.file {
+		for _, src := range file.App {
+			app, ok := apps[src.ID]
+			if !ok {
+				app = &App{ID: src.ID}
+				apps[src.ID] = app
+				f.App = append(f.App, app)
+			}
+			if src.Type != "" {
+				app.Type = src.Type
+			}
+			if src.Name != "" {
+				app.Name = src.Name
+			}
+			for _, v := range src.Vendor {
+				k := codeIdx{src.ID, v.ID}
+				if !vendors[k] {
+					vendors[k] = true
+					app.Vendor = append(app.Vendor, v)
+				}
+			}
+			for _, cmd := range src.Command {
+				k := codeIdx{src.ID, cmd.Code}
+				if i, ok := commands[k]; ok {
+					app.Command[i] = cmd
+					continue
+				}
+				commands[k] = len(app.Command)
+				app.Command = append(app.Command, cmd)
+			}
+			for _, avp := range src.AVP {
+				k := vendorIdx{src.ID, avp.Code, avp.VendorID}
+				if i, ok := avps[k]; ok {
+					app.AVP[i] = avp
+					continue
+				}
+				avps[k] = len(app.AVP)
+				app.AVP = append(app.AVP, avp)
+			}
+		}
+	}
+	return f
+}
+
 func updateType(a *AVP) error {
 	id, exists := datatype.Available[a.Data.TypeName]
 	if !exists {
@@ -118,7 +328,7 @@ func updateType(a *AVP) error {
 // String returns the Parser represented in a human readable form.
 func (p *Parser) String() string {
 	var b bytes.Buffer
-	for _, f := range p.file {
+	for _, f := range p.index().file {
 		for _, app := range f.App {
 			fmt.Fprintf(&b, "Application Id: %d\n", app.ID)
 			fmt.Fprintf(&b, "\tVendors:\n")
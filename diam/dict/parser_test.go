@@ -5,11 +5,16 @@
 package dict
 
 import (
+	"bytes"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 )
 
-const testDict = "./testdata/base.xml"
+const testDict = "./xml/base.xml"
 
 func TestNewParser(t *testing.T) {
 	p, err := NewParser(testDict)
@@ -37,3 +42,156 @@ func TestLoad(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+const testReloadDict = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="1000">
+		<avp name="Test-AVP" code="65000" must="M">
+			<data type="%s"/>
+		</avp>
+	</application>
+</diameter>`
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dict")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "test.xml")
+	writeDict := func(typ string) {
+		b := []byte(strings.Replace(testReloadDict, "%s", typ, 1))
+		if err := ioutil.WriteFile(name, b, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeDict("Unsigned32")
+	p, err := NewParser(testDict, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old, err := p.FindAVP(1000, 65000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Lookups run concurrently with Reload.
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if _, err := p.FindAVP(1000, "Session-Id"); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	writeDict("UTF8String")
+	if err = p.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	avp, err := p.FindAVP(1000, 65000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Data.TypeName != "UTF8String" {
+		t.Fatalf("Unexpected type. Want UTF8String, have %s", avp.Data.TypeName)
+	}
+	if old.Data.TypeName != "Unsigned32" {
+		t.Fatalf("Unexpected change of AVP found before Reload: %s", old.Data.TypeName)
+	}
+
+	// Invalid dictionaries keep the previous ones.
+	writeDict("Unknown")
+	if err = p.Reload(); err == nil {
+		t.Fatal("Unexpected success reloading an invalid dictionary")
+	}
+	if avp, err = p.FindAVP(1000, 65000); err != nil || avp.Data.TypeName != "UTF8String" {
+		t.Fatalf("Unexpected AVP after failed Reload: %v, %v", avp, err)
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestLoadInvalidKeepsParser(t *testing.T) {
+	p, err := NewParser(testDict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(p.Apps())
+	if err = p.Load(strings.NewReader(strings.Replace(testReloadDict, "%s", "Unknown", 1))); err == nil {
+		t.Fatal("Unexpected success loading an invalid dictionary")
+	}
+	if len(p.Apps()) != n {
+		t.Fatalf("Unexpected apps after failed Load. Want %d, have %d", n, len(p.Apps()))
+	}
+	if _, err = p.FindAVP(1000, 65000); err == nil {
+		t.Fatal("Unexpected AVP from failed Load")
+	}
+}
+
+func TestDump(t *testing.T) {
+	p, err := NewParser(testDict, "./xml/credit_control.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Redefine an AVP of the base dictionary, in a second file.
+	override := `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="0">
+		<avp name="User-Name" code="1" must="M">
+			<data type="OctetString"/>
+		</avp>
+	</application>
+</diameter>`
+	if err = p.Load(strings.NewReader(override)); err != nil {
+		t.Fatal(err)
+	}
+	for _, format := range []Format{XML, JSON} {
+		var b bytes.Buffer
+		if err = p.Dump(&b, format); err != nil {
+			t.Fatal(err)
+		}
+		f, err := Decode(b.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(f.App) != len(p.Apps())-1 {
+			t.Fatalf("%s: unexpected # of apps. Want %d, have %d",
+				format, len(p.Apps())-1, len(f.App))
+		}
+		d, err := NewParser()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = d.Load(&b); err != nil {
+			t.Fatal(err)
+		}
+		for _, app := range p.Apps() {
+			for _, avp := range app.AVP {
+				want, _ := p.FindAVP(app.ID, avp.Code)
+				have, err := d.FindAVP(app.ID, avp.Code)
+				if err != nil {
+					t.Fatalf("%s: %s", format, err)
+				}
+				if have.Name != want.Name || have.Data.Type != want.Data.Type {
+					t.Fatalf("%s: unexpected AVP. Want %#v, have %#v",
+						format, want, have)
+				}
+			}
+		}
+		avp, _ := d.FindAVP(0, 1)
+		if avp.Data.TypeName != "OctetString" {
+			t.Fatalf("%s: unexpected User-Name type %s",
+				format, avp.Data.TypeName)
+		}
+	}
+}
@@ -8,77 +8,101 @@ package dict
 
 import (
 	"encoding/xml"
+	"strings"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
 )
 
 // File is the dictionary root element of a XML file.  See diam_base.xml.
 type File struct {
-	XMLName xml.Name `xml:"diameter"`
-	App     []*App   `xml:"application"` // Support for multiple applications
+	XMLName xml.Name `xml:"diameter" json:"-" yaml:"-"`
+	App     []*App   `xml:"application" json:"application" yaml:"application"` // Support for multiple applications
 }
 
 // App defines a diameter application in XML and its multiple AVPs.
 type App struct {
-	ID      uint32     `xml:"id,attr"`   // Application Id
-	Type    string     `xml:"type,attr"` // Application type
-	Name    string     `xml:"name,attr"` // Application name
-	Vendor  []*Vendor  `xml:"vendor"`    // Support for multiple vendors
-	Command []*Command `xml:"command"`   // Diameter commands
-	AVP     []*AVP     `xml:"avp"`       // Each application support multiple AVPs
+	ID      uint32     `xml:"id,attr" json:"id" yaml:"id"`                               // Application Id
+	Type    string     `xml:"type,attr" json:"type,omitempty" yaml:"type,omitempty"`     // Application type
+	Name    string     `xml:"name,attr" json:"name,omitempty" yaml:"name,omitempty"`     // Application name
+	Vendor  []*Vendor  `xml:"vendor" json:"vendor,omitempty" yaml:"vendor,omitempty"`    // Support for multiple vendors
+	Command []*Command `xml:"command" json:"command,omitempty" yaml:"command,omitempty"` // Diameter commands
+	AVP     []*AVP     `xml:"avp" json:"avp,omitempty" yaml:"avp,omitempty"`             // Each application support multiple AVPs
 }
 
 // Vendor defines diameter vendors in XML, that can be used to translate
 // the VendorId AVP of incoming messages.
 type Vendor struct {
-	ID   uint32 `xml:"id,attr"`
-	Name string `xml:"name,attr"`
+	ID   uint32 `xml:"id,attr" json:"id" yaml:"id"`
+	Name string `xml:"name,attr" json:"name" yaml:"name"`
 }
 
 // Command defines a diameter command (CE, CC, etc)
 type Command struct {
-	Code    uint32      `xml:"code,attr"`
-	Name    string      `xml:"name,attr"`
-	Short   string      `xml:"short,attr"`
-	Request CommandRule `xml:"request"`
-	Answer  CommandRule `xml:"answer"`
+	Code    uint32      `xml:"code,attr" json:"code" yaml:"code"`
+	Name    string      `xml:"name,attr" json:"name" yaml:"name"`
+	Short   string      `xml:"short,attr" json:"short" yaml:"short"`
+	Request CommandRule `xml:"request" json:"request" yaml:"request"`
+	Answer  CommandRule `xml:"answer" json:"answer" yaml:"answer"`
 }
 
 // CommandRule contains rules for a given command.
 type CommandRule struct {
-	Rule []*Rule `xml:"rule"`
+	Rule []*Rule `xml:"rule" json:"rule,omitempty" yaml:"rule,omitempty"`
 }
 
 // AVP represents a dictionary AVP that is loaded from XML.
 type AVP struct {
-	Name       string `xml:"name,attr"`
-	Code       uint32 `xml:"code,attr"`
-	Must       string `xml:"must,attr"`
-	May        string `xml:"may,attr"`
-	MustNot    string `xml:"must-not,attr"`
-	MayEncrypt string `xml:"may-encrypt,attr"`
-	Data       Data   `xml:"data"`
-	App        *App   `xml:"none"` // Link back to diameter application
+	Name       string `xml:"name,attr" json:"name" yaml:"name"`
+	Code       uint32 `xml:"code,attr" json:"code" yaml:"code"`
+	Must       string `xml:"must,attr,omitempty" json:"must,omitempty" yaml:"must,omitempty"`
+	May        string `xml:"may,attr,omitempty" json:"may,omitempty" yaml:"may,omitempty"`
+	MustNot    string `xml:"must-not,attr,omitempty" json:"must-not,omitempty" yaml:"must-not,omitempty"`
+	MayEncrypt string `xml:"may-encrypt,attr,omitempty" json:"may-encrypt,omitempty" yaml:"may-encrypt,omitempty"`
+	VendorID   uint32 `xml:"vendor-id,attr,omitempty" json:"vendor-id,omitempty" yaml:"vendor-id,omitempty"` // See App.AVPVendorID
+	Data       Data   `xml:"data" json:"data" yaml:"data"`
+	App        *App   `xml:"-" json:"-" yaml:"-"` // Link back to diameter application
+}
+
+// AVPVendorID returns the vendor id of an AVP of the application. It
+// is the VendorID of the AVP when set, otherwise the vendor of the
+// application (the last one declared) for AVPs that must have the 'V'
+// bit, and zero for IETF AVPs.
+func (app *App) AVPVendorID(avp *AVP) uint32 {
+	if avp.VendorID != 0 || !strings.Contains(avp.Must, "V") {
+		return avp.VendorID
+	}
+	for i := len(app.Vendor) - 1; i >= 0; i-- {
+		if app.Vendor[i].ID != 0 {
+			return app.Vendor[i].ID
+		}
+	}
+	return 0
 }
 
 // Data of an AVP can be EnumItem or a Parser of multiple AVPs.
 type Data struct {
-	Type     datatype.TypeID `xml:"-"`
-	TypeName string          `xml:"type,attr"`
-	Enum     []*Enum         `xml:"item"` // In case of Enumerated AVP data
-	Rule     []*Rule         `xml:"rule"` // In case of Grouped AVPs
+	Type     datatype.TypeID `xml:"-" json:"-" yaml:"-"`
+	TypeName string          `xml:"type,attr" json:"type" yaml:"type"`
+	Enum     []*Enum         `xml:"item" json:"item,omitempty" yaml:"item,omitempty"` // In case of Enumerated AVP data
+	Rule     []*Rule         `xml:"rule" json:"rule,omitempty" yaml:"rule,omitempty"` // In case of Grouped AVPs
 }
 
-// Enum contains the code and name of Enumerated items.
+// Enum contains the code and name of Enumerated items. Enumerated is
+// derived from Integer32, so codes may be negative or larger than 255,
+// e.g. the RAT-Type values of 3GPP.
 type Enum struct {
-	Code uint8  `xml:"code,attr"`
-	Name string `xml:"name,attr"`
+	Code int32  `xml:"code,attr" json:"code" yaml:"code"`
+	Name string `xml:"name,attr" json:"name" yaml:"name"`
 }
 
-// Rule defines the usage rules of an AVP.
+// Rule defines the usage rules of an AVP, in a command or a Grouped
+// AVP: whether it is required, and its min and max occurrences, where
+// a zero max means no limit. A forbidden AVP must not occur, like
+// 0*0[AVP] in the ABNF of RFC 6733.
 type Rule struct {
-	AVP      string `xml:"avp,attr"` // AVP Name
-	Required bool   `xml:"required,attr"`
-	Min      int    `xml:"min,attr"`
-	Max      int    `xml:"max,attr"`
+	AVP       string `xml:"avp,attr" json:"avp" yaml:"avp"` // AVP Name
+	Required  bool   `xml:"required,attr" json:"required" yaml:"required"`
+	Min       int    `xml:"min,attr" json:"min" yaml:"min"`
+	Max       int    `xml:"max,attr" json:"max" yaml:"max"`
+	Forbidden bool   `xml:"forbidden,attr,omitempty" json:"forbidden,omitempty" yaml:"forbidden,omitempty"`
 }
@@ -10,16 +10,13 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
 )
 
 // Apps return a list of all applications loaded in the Parser object.
-// Apps must never be called concurrently with LoadFile or Load.
 func (p *Parser) Apps() []*App {
-	//p.mu.Lock()
-	//defer p.mu.Unlock()
 	var apps []*App
-	for _, f := range p.file {
+	for _, f := range p.index().file {
 		for _, app := range f.App {
 			apps = append(apps, app)
 		}
@@ -28,9 +25,9 @@ func (p *Parser) Apps() []*App {
 }
 
 // App returns a dictionary application for the given application code
-// if exists. App must never be called concurrently with LoadFile or Load.
+// if exists.
 func (p *Parser) App(code uint32) (*App, error) {
-	app := p.appcode[code]
+	app := p.index().appcode[code]
 	if app == nil {
 		return nil, ErrApplicationUnsupported
 	}
@@ -46,43 +43,84 @@ var ErrApplicationUnsupported = errors.New("application unsupported")
 // before returning an error.
 // Code can be either the AVP code (int, uint32) or name (string).
 //
-// FindAVP must never be called concurrently with LoadFile or Load.
+// AVP codes are only unique within a vendor, and codes are looked up
+// as IETF AVPs: FindAVP(appid, code) is FindAVPWithVendor(appid, code, 0).
 func (p *Parser) FindAVP(appid uint32, code interface{}) (*AVP, error) {
-	//p.mu.Lock()
-	//defer p.mu.Unlock()
-	var (
-		avp *AVP
-		ok  bool
-		err error
-	)
-retry:
 	switch code.(type) {
 	case string:
-		avp, ok = p.avpname[nameIdx{appid, code.(string)}]
-		if !ok && appid == 0 {
-			err = fmt.Errorf("Could not find AVP %s", code.(string))
+		idx := p.index()
+		if avp, ok := idx.avpname[nameIdx{appid, code.(string)}]; ok {
+			return avp, nil
 		}
-	case uint32:
-		avp, ok = p.avpcode[codeIdx{appid, code.(uint32)}]
-		if !ok && appid == 0 {
-			err = fmt.Errorf("Could not find AVP %d", code.(uint32))
+		if avp, ok := idx.avpname[nameIdx{0, code.(string)}]; ok {
+			return avp, nil
 		}
+		return nil, fmt.Errorf("Could not find AVP %s", code.(string))
+	case uint32:
+		return p.FindAVPWithVendor(appid, code.(uint32), 0)
 	case int:
-		avp, ok = p.avpcode[codeIdx{appid, uint32(code.(int))}]
-		if !ok && appid == 0 {
-			err = fmt.Errorf("Could not find AVP %d", code.(int))
+		return p.FindAVPWithVendor(appid, uint32(code.(int)), 0)
+	}
+	return nil, fmt.Errorf("Unsupported AVP code type %#v", code)
+}
+
+// FindAVPWithVendor returns the pre-loaded AVP with the given code and
+// vendor id, as received in the AVP header. The AVP is searched in this
+// order, returning the first found:
+//
+//  1. The AVP with code and vendorID in appid.
+//  2. The AVP with code and vendorID in the base dictionary (appid=0).
+//  3. The AVP with code of any vendor in appid.
+//  4. The AVP with code of any vendor in the base dictionary.
+//
+// The last two steps accept AVPs sent without the vendor they are
+// defined with. When more than one vendor defines the code, the one
+// loaded last is returned. Use FindAllAVPs to list them all.
+func (p *Parser) FindAVPWithVendor(appid, code, vendorID uint32) (*AVP, error) {
+	idx := p.index()
+	apps := []uint32{appid, 0}
+	if appid == 0 {
+		apps = apps[:1]
+	}
+	for _, id := range apps {
+		for _, avp := range idx.avpcode[codeIdx{id, code}] {
+			if avp.VendorID == vendorID {
+				return avp, nil
+			}
+		}
+	}
+	for _, id := range apps {
+		if l := idx.avpcode[codeIdx{id, code}]; len(l) > 0 {
+			return l[len(l)-1], nil
 		}
-	default:
-		return nil, fmt.Errorf("Unsupported AVP code type %#v", code)
 	}
-	if ok {
-		return avp, nil
-	} else if appid != 0 {
-		// Try searching the base dictionary.
-		appid = 0
-		goto retry
+	return nil, fmt.Errorf("Could not find AVP %d", code)
+}
+
+// FindAllAVPs returns all pre-loaded AVPs of appid that match code,
+// followed by the ones of the base dictionary (appid=0). Code can be
+// either the AVP code (int, uint32), which may be defined by multiple
+// vendors, or name (string). It returns nil if no AVP matches.
+func (p *Parser) FindAllAVPs(appid uint32, code interface{}) []*AVP {
+	idx := p.index()
+	apps := []uint32{appid, 0}
+	if appid == 0 {
+		apps = apps[:1]
+	}
+	var avps []*AVP
+	for _, id := range apps {
+		switch code.(type) {
+		case string:
+			if avp, ok := idx.avpname[nameIdx{id, code.(string)}]; ok {
+				avps = append(avps, avp)
+			}
+		case uint32:
+			avps = append(avps, idx.avpcode[codeIdx{id, code.(uint32)}]...)
+		case int:
+			avps = append(avps, idx.avpcode[codeIdx{id, uint32(code.(int))}]...)
+		}
 	}
-	return nil, err
+	return avps
 }
 
 // ScanAVP is a helper function that returns a pre-loaded AVP from the Dict.
@@ -91,30 +129,27 @@ retry:
 //
 // ScanAVP is 20x or more slower than FindAVP. Use with care.
 // Code can be either the AVP code (uint32) or name (string).
-//
-// ScanAVP must never be called concurrently with LoadFile or Load.
 func (p *Parser) ScanAVP(code interface{}) (*AVP, error) {
-	//p.mu.Lock()
-	//defer p.mu.Unlock()
+	idx := p.index()
 	switch code.(type) {
 	case string:
-		for idx, avp := range p.avpname {
-			if idx.name == code.(string) {
+		for k, avp := range idx.avpname {
+			if k.name == code.(string) {
 				return avp, nil
 			}
 		}
 		return nil, fmt.Errorf("Could not find AVP %s", code.(string))
 	case uint32:
-		for idx, avp := range p.avpcode {
-			if idx.code == code.(uint32) {
-				return avp, nil
+		for k, avps := range idx.avpcode {
+			if k.code == code.(uint32) {
+				return avps[len(avps)-1], nil
 			}
 		}
 		return nil, fmt.Errorf("Could not find AVP code %d", code.(uint32))
 	case int:
-		for idx, avp := range p.avpcode {
-			if idx.code == uint32(code.(int)) {
-				return avp, nil
+		for k, avps := range idx.avpcode {
+			if k.code == uint32(code.(int)) {
+				return avps[len(avps)-1], nil
 			}
 		}
 		return nil, fmt.Errorf("Could not find AVP code %d", code.(int))
@@ -123,14 +158,11 @@ func (p *Parser) ScanAVP(code interface{}) (*AVP, error) {
 }
 
 // FindCommand returns a pre-loaded Command from the Parser.
-//
-// FindCommand must never be called concurrently with LoadFile or Load.
 func (p *Parser) FindCommand(appid, code uint32) (*Command, error) {
-	//p.mu.Lock()
-	//defer p.mu.Unlock()
-	if cmd, ok := p.command[codeIdx{appid, code}]; ok {
+	idx := p.index()
+	if cmd, ok := idx.command[codeIdx{appid, code}]; ok {
 		return cmd, nil
-	} else if cmd, ok = p.command[codeIdx{0, code}]; ok {
+	} else if cmd, ok = idx.command[codeIdx{0, code}]; ok {
 		// Always fall back to base dict.
 		return cmd, nil
 	}
@@ -139,9 +171,7 @@ func (p *Parser) FindCommand(appid, code uint32) (*Command, error) {
 
 // Enum is a helper function that returns a pre-loaded Enum item for the
 // given AVP appid, code and n. (n is the enum code in the dictionary)
-//
-// Enum must never be called concurrently with LoadFile or Load.
-func (p *Parser) Enum(appid, code uint32, n uint8) (*Enum, error) {
+func (p *Parser) Enum(appid, code uint32, n int32) (*Enum, error) {
 	avp, err := p.FindAVP(appid, code)
 	if err != nil {
 		return nil, err
@@ -161,10 +191,41 @@ func (p *Parser) Enum(appid, code uint32, n uint8) (*Enum, error) {
 		n, avp.Name, avp.Code)
 }
 
+// EnumName returns the name of the Enumerated value of the AVP with the
+// given appid and code, for example "INITIAL_REQUEST" for the value 1
+// of CC-Request-Type.
+func (p *Parser) EnumName(appid, code uint32, value int32) (string, error) {
+	item, err := p.Enum(appid, code, value)
+	if err != nil {
+		return "", err
+	}
+	return item.Name, nil
+}
+
+// EnumValue returns the Enumerated value with the given name, of the
+// AVP with the given appid and code. It is the inverse of EnumName.
+func (p *Parser) EnumValue(appid, code uint32, name string) (int32, error) {
+	avp, err := p.FindAVP(appid, code)
+	if err != nil {
+		return 0, err
+	}
+	if avp.Data.Type != datatype.EnumeratedType {
+		return 0, fmt.Errorf(
+			"Data of AVP %s (%d) data is not Enumerated.",
+			avp.Name, avp.Code)
+	}
+	for _, item := range avp.Data.Enum {
+		if item.Name == name {
+			return item.Code, nil
+		}
+	}
+	return 0, fmt.Errorf(
+		"Could not find preload Enum %s for AVP %s (%d)",
+		name, avp.Name, avp.Code)
+}
+
 // Rule is a helper function that returns a pre-loaded Rule item for the
 // given AVP code and name.
-//
-// Rule must never be called concurrently with LoadFile or Load.
 func (p *Parser) Rule(appid, code uint32, n string) (*Rule, error) {
 	avp, err := p.FindAVP(appid, code)
 	if err != nil {
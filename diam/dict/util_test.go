@@ -8,8 +8,8 @@ import "testing"
 
 func TestApps(t *testing.T) {
 	apps := Default.Apps()
-	if len(apps) != 3 {
-		t.Fatalf("Unexpected # of apps. Want 3, have %d", len(apps))
+	if len(apps) != 11 {
+		t.Fatalf("Unexpected # of apps. Want 11, have %d", len(apps))
 	}
 	// Base protocol.
 	if apps[0].ID != 0 {
@@ -38,6 +38,57 @@ func TestFindAVP(t *testing.T) {
 	}
 }
 
+func TestFindAVPWithVendor(t *testing.T) {
+	// User-Name and the 3GPP TGPP-IMSI are both code 1.
+	for _, test := range []struct {
+		code, vendor uint32
+		want         string
+	}{
+		{1, 0, "User-Name"},
+		{1, 10415, "TGPP-IMSI"},
+		{1, 13, "TGPP-IMSI"},
+		{11, 0, "Filter-Id"},
+		{11, 10415, "TGPP-Session-Stop-Indicator"},
+		{263, 10415, "Session-Id"},
+	} {
+		avp, err := Default.FindAVPWithVendor(4, test.code, test.vendor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if avp.Name != test.want {
+			t.Fatalf("Unexpected AVP %d of vendor %d. Want %s, have %s",
+				test.code, test.vendor, test.want, avp.Name)
+		}
+	}
+	if avp, err := Default.FindAVP(4, 1); err != nil {
+		t.Fatal(err)
+	} else if avp.Name != "User-Name" || avp.VendorID != 0 {
+		t.Fatalf("Unexpected AVP: %s (vendor %d)", avp.Name, avp.VendorID)
+	}
+	if _, err := Default.FindAVPWithVendor(4, 65000, 0); err == nil {
+		t.Fatal("Unexpected AVP 65000")
+	}
+}
+
+func TestFindAllAVPs(t *testing.T) {
+	avps := Default.FindAllAVPs(4, 1)
+	if len(avps) != 2 {
+		t.Fatalf("Unexpected # of AVPs. Want 2, have %d", len(avps))
+	}
+	if avps[0].Name != "TGPP-IMSI" || avps[0].VendorID != 10415 {
+		t.Fatalf("Unexpected AVP: %s (vendor %d)", avps[0].Name, avps[0].VendorID)
+	}
+	if avps[1].Name != "User-Name" || avps[1].VendorID != 0 {
+		t.Fatalf("Unexpected AVP: %s (vendor %d)", avps[1].Name, avps[1].VendorID)
+	}
+	if avps = Default.FindAllAVPs(4, "Session-Id"); len(avps) != 1 {
+		t.Fatalf("Unexpected # of AVPs. Want 1, have %d", len(avps))
+	}
+	if avps = Default.FindAllAVPs(4, 65000); avps != nil {
+		t.Fatalf("Unexpected AVPs: %v", avps)
+	}
+}
+
 func TestScanAVP(t *testing.T) {
 	if avp, err := Default.ScanAVP("Session-Id"); err != nil {
 		t.Error(err)
@@ -65,6 +116,36 @@ func TestEnum(t *testing.T) {
 	}
 }
 
+func TestEnumName(t *testing.T) {
+	name, err := Default.EnumName(4, 416, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "EVENT_REQUEST" {
+		t.Fatalf("Unexpected name. Want EVENT_REQUEST, have %s", name)
+	}
+	v, err := Default.EnumValue(4, 416, "EVENT_REQUEST")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 4 {
+		t.Fatalf("Unexpected value. Want 4, have %d", v)
+	}
+	if _, err = Default.EnumName(4, 416, 1000); err == nil {
+		t.Fatal("Unexpected name for value 1000")
+	}
+	// RAT-Type values don't fit in a byte.
+	if name, err = Default.EnumName(4, 1032, 1004); err != nil {
+		t.Fatal(err)
+	}
+	if name != "EUTRAN" {
+		t.Fatalf("Unexpected name. Want EUTRAN, have %s", name)
+	}
+	if _, err = Default.EnumValue(0, 264, "INITIAL_REQUEST"); err == nil {
+		t.Fatal("Unexpected value for non Enumerated AVP")
+	}
+}
+
 func TestRule(t *testing.T) {
 	if rule, err := Default.Rule(0, 284, "Proxy-Host"); err != nil {
 		t.Fatal(err)
@@ -96,3 +177,161 @@ func BenchmarkScanAVPCode(b *testing.B) {
 		Default.ScanAVP(263)
 	}
 }
+
+func TestS6a(t *testing.T) {
+	const s6a = 16777251
+	cmd, err := Default.FindCommand(s6a, 316)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Name != "Update-Location" {
+		t.Fatalf("Unexpected command. Want Update-Location, have %s", cmd.Name)
+	}
+	avp, err := Default.FindAVP(s6a, "Subscription-Data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 1400 || avp.Data.TypeName != "Grouped" {
+		t.Fatalf("Unexpected AVP: %#v", avp)
+	}
+	// Base protocol AVPs are found through the S6a application.
+	if _, err = Default.FindAVP(s6a, "Session-Id"); err != nil {
+		t.Fatal(err)
+	}
+	name, err := Default.EnumName(s6a, 1420, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "SUBSCRIPTION_WITHDRAWAL" {
+		t.Fatalf("Unexpected Cancellation-Type. Want SUBSCRIPTION_WITHDRAWAL, have %s", name)
+	}
+}
+
+func TestGx(t *testing.T) {
+	const gx = 16777238
+	cmd, err := Default.FindCommand(gx, 272)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Name != "Credit-Control" {
+		t.Fatalf("Unexpected command. Want Credit-Control, have %s", cmd.Name)
+	}
+	avp, err := Default.FindAVP(gx, "Charging-Rule-Install")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 1001 || avp.Data.TypeName != "Grouped" {
+		t.Fatalf("Unexpected AVP: %#v", avp)
+	}
+	// Credit Control AVPs are also part of Gx.
+	if _, err = Default.FindAVP(gx, "CC-Request-Type"); err != nil {
+		t.Fatal(err)
+	}
+	name, err := Default.EnumName(gx, 1006, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "USER_LOCATION_CHANGE" {
+		t.Fatalf("Unexpected Event-Trigger. Want USER_LOCATION_CHANGE, have %s", name)
+	}
+}
+
+func TestRx(t *testing.T) {
+	const rx = 16777236
+	cmd, err := Default.FindCommand(rx, 265)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Short != "AA" {
+		t.Fatalf("Unexpected command. Want AA, have %s", cmd.Short)
+	}
+	avp, err := Default.FindAVP(rx, "Media-Component-Description")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 517 || avp.Data.TypeName != "Grouped" {
+		t.Fatalf("Unexpected AVP: %#v", avp)
+	}
+	name, err := Default.EnumName(rx, 520, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "VIDEO" {
+		t.Fatalf("Unexpected Media-Type. Want VIDEO, have %s", name)
+	}
+	v, err := Default.EnumValue(rx, 520, "OTHER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -1 {
+		t.Fatalf("Unexpected Media-Type value. Want -1, have %d", v)
+	}
+}
+
+func TestCxSh(t *testing.T) {
+	const cx, sh = 16777216, 16777217
+	cmd, err := Default.FindCommand(cx, 303)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Name != "Multimedia-Auth" {
+		t.Fatalf("Unexpected command. Want Multimedia-Auth, have %s", cmd.Name)
+	}
+	avp, err := Default.FindAVP(cx, "SIP-Auth-Data-Item")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 612 || avp.Data.TypeName != "Grouped" {
+		t.Fatalf("Unexpected AVP: %#v", avp)
+	}
+	if cmd, err = Default.FindCommand(sh, 306); err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Name != "User-Data" {
+		t.Fatalf("Unexpected command. Want User-Data, have %s", cmd.Name)
+	}
+	// Cx and Sh define different AVPs named User-Data.
+	if avp, err = Default.FindAVP(sh, "Sh-User-Data"); err != nil {
+		t.Fatal(err)
+	}
+	if avp.Code != 702 {
+		t.Fatalf("Unexpected Sh-User-Data code. Want 702, have %d", avp.Code)
+	}
+	name, err := Default.EnumName(sh, 703, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "LocationInformation" {
+		t.Fatalf("Unexpected Data-Reference. Want LocationInformation, have %s", name)
+	}
+}
+
+func TestNon3GPP(t *testing.T) {
+	const swx, sta, s6b = 16777265, 16777250, 16777272
+	cmd, err := Default.FindCommand(swx, 301)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Name != "Server-Assignment" {
+		t.Fatalf("Unexpected command. Want Server-Assignment, have %s", cmd.Name)
+	}
+	if _, err = Default.FindAVP(swx, "Non-3GPP-User-Data"); err != nil {
+		t.Fatal(err)
+	}
+	for _, appid := range []uint32{sta, s6b} {
+		cmd, err = Default.FindCommand(appid, 268)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cmd.Short != "DE" {
+			t.Fatalf("Unexpected command. Want DE, have %s", cmd.Short)
+		}
+		avp, err := Default.FindAVP(appid, "EAP-Payload")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if avp.Code != 462 {
+			t.Fatalf("Unexpected EAP-Payload code. Want 462, have %d", avp.Code)
+		}
+	}
+}
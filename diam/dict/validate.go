@@ -0,0 +1,161 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Dictionary validation.  Part of go-diameter.
+
+package dict
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// ProblemKind is the kind of a Problem found by Validate.
+type ProblemKind int
+
+// Kinds of problems found by Validate.
+const (
+	DanglingReference ProblemKind = iota // Rule references an unknown AVP
+	UnknownType                          // AVP data type is not supported
+	DuplicateCode                        // Code used by two commands or AVPs
+	MissingBaseAVP                       // Base protocol AVP is not defined
+)
+
+// Problem is an error found in a dictionary by Validate.
+type Problem struct {
+	Kind  ProblemKind
+	AppID uint32 // Application of the item
+	Name  string // Name of the command or AVP
+	Msg   string
+}
+
+// String returns the problem in a human readable form.
+func (p *Problem) String() string {
+	return fmt.Sprintf("application %d: %s", p.AppID, p.Msg)
+}
+
+// Report is the result of Validate.
+type Report struct {
+	Problems []*Problem
+}
+
+// OK reports whether no problems were found.
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// String returns the problems of the report, one per line.
+func (r *Report) String() string {
+	var b bytes.Buffer
+	for _, p := range r.Problems {
+		fmt.Fprintln(&b, p)
+	}
+	return b.String()
+}
+
+// baseAVPs are the AVPs of the base protocol used by the state machine
+// and the message helpers, which every dictionary must define in
+// application 0. See RFC 6733 section 4.5.
+var baseAVPs = []string{
+	"Acct-Application-Id",
+	"Auth-Application-Id",
+	"Destination-Host",
+	"Destination-Realm",
+	"Error-Message",
+	"Failed-AVP",
+	"Firmware-Revision",
+	"Host-IP-Address",
+	"Origin-Host",
+	"Origin-Realm",
+	"Origin-State-Id",
+	"Product-Name",
+	"Result-Code",
+	"Session-Id",
+	"Vendor-Id",
+	"Vendor-Specific-Application-Id",
+}
+
+// Validate checks the applications of a dictionary, such as the ones
+// returned by Parser.Apps or decoded by Decode, for:
+//
+//   - command and Grouped AVP rules that reference unknown AVPs
+//   - AVPs with unsupported data types
+//   - command or AVP codes defined twice with different names
+//   - base protocol AVPs that are not defined
+//
+// Applications are validated as loaded by a Parser: later definitions
+// of an item with the same name override earlier ones, and AVPs are
+// looked up in their application and then in the base protocol.
+func Validate(apps []*App) *Report {
+	r := new(Report)
+	report := func(kind ProblemKind, app *App, name, format string, a ...interface{}) {
+		r.Problems = append(r.Problems, &Problem{
+			Kind:  kind,
+			AppID: app.ID,
+			Name:  name,
+			Msg:   fmt.Sprintf(format, a...),
+		})
+	}
+	avpname := make(map[nameIdx]*AVP)
+	avpcode := make(map[vendorIdx]*AVP)
+	command := make(map[codeIdx]*Command)
+	for _, app := range apps {
+		for _, cmd := range app.Command {
+			k := codeIdx{app.ID, cmd.Code}
+			if c, ok := command[k]; ok && c.Name != cmd.Name {
+				report(DuplicateCode, app, cmd.Name,
+					"command code %d is used by %s and %s", cmd.Code, c.Name, cmd.Name)
+			}
+			command[k] = cmd
+		}
+		for _, avp := range app.AVP {
+			k := vendorIdx{app.ID, avp.Code, app.AVPVendorID(avp)}
+			if a, ok := avpcode[k]; ok && a.Name != avp.Name {
+				report(DuplicateCode, app, avp.Name,
+					"AVP code %d is used by %s and %s", avp.Code, a.Name, avp.Name)
+			}
+			avpcode[k] = avp
+			avpname[nameIdx{app.ID, avp.Name}] = avp
+			if _, ok := datatype.Available[avp.Data.TypeName]; !ok {
+				report(UnknownType, app, avp.Name,
+					"AVP %s has unsupported data type %q", avp.Name, avp.Data.TypeName)
+			}
+		}
+	}
+	dangling := func(app *App, name, where string, rules []*Rule) {
+		for _, rule := range rules {
+			if rule.AVP == "AVP" {
+				// Any AVP.
+				continue
+			}
+			if avpname[nameIdx{app.ID, rule.AVP}] == nil && avpname[nameIdx{0, rule.AVP}] == nil {
+				report(DanglingReference, app, name,
+					"%s: rule references unknown AVP %s", where, rule.AVP)
+			}
+		}
+	}
+	for _, app := range apps {
+		for _, cmd := range app.Command {
+			dangling(app, cmd.Name, cmd.Name+"-Request", cmd.Request.Rule)
+			dangling(app, cmd.Name, cmd.Name+"-Answer", cmd.Answer.Rule)
+		}
+		for _, avp := range app.AVP {
+			dangling(app, avp.Name, avp.Name, avp.Data.Rule)
+		}
+	}
+	base := &App{ID: 0}
+	for _, name := range baseAVPs {
+		if avpname[nameIdx{0, name}] == nil {
+			report(MissingBaseAVP, base, name, "base protocol AVP %s is not defined", name)
+		}
+	}
+	return r
+}
+
+// Validate checks the dictionaries loaded in the Parser. See Validate.
+func (p *Parser) Validate() *Report {
+	return Validate(p.Apps())
+}
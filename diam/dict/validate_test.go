@@ -0,0 +1,78 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dict
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDefault(t *testing.T) {
+	if r := Default.Validate(); !r.OK() {
+		t.Fatalf("Unexpected problems:\n%s", r)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	f, err := Decode([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="1000">
+		<vendor id="99999" name="Test"/>
+		<command code="9000" short="TS" name="Test">
+			<request>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Test-Missing" required="true" max="1"/>
+			</request>
+		</command>
+		<command code="9000" short="TD" name="Duplicate">
+		</command>
+		<avp name="Test-Int" code="9001" must="M">
+			<data type="Integer32"/>
+		</avp>
+		<avp name="Test-Dup" code="9001" must="M">
+			<data type="Integer33"/>
+		</avp>
+		<avp name="Test-Vendor" code="9001" must="V,M">
+			<data type="Grouped">
+				<rule avp="Test-Int" required="true"/>
+				<rule avp="AVP" required="false"/>
+			</data>
+		</avp>
+	</application>
+</diameter>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := Validate(append(Default.Apps(), f.App...))
+	want := []struct {
+		kind ProblemKind
+		name string
+		msg  string
+	}{
+		{DuplicateCode, "Duplicate", "command code 9000 is used by Test and Duplicate"},
+		{DuplicateCode, "Test-Dup", "AVP code 9001 is used by Test-Int and Test-Dup"},
+		{UnknownType, "Test-Dup", `AVP Test-Dup has unsupported data type "Integer33"`},
+		{DanglingReference, "Test", "Test-Request: rule references unknown AVP Test-Missing"},
+	}
+	if len(r.Problems) != len(want) {
+		t.Fatalf("Unexpected problems. Want %d, have %d:\n%s", len(want), len(r.Problems), r)
+	}
+	for n, p := range r.Problems {
+		if p.Kind != want[n].kind || p.AppID != 1000 || p.Name != want[n].name || p.Msg != want[n].msg {
+			t.Fatalf("Unexpected problem #%d. Want %s, have %s", n, want[n].msg, p)
+		}
+	}
+	// Without the base protocol.
+	r = Validate(f.App)
+	var missing []string
+	for _, p := range r.Problems {
+		if p.Kind == MissingBaseAVP {
+			missing = append(missing, p.Name)
+		}
+	}
+	if len(missing) != len(baseAVPs) || !strings.Contains(r.String(), "Session-Id") {
+		t.Fatalf("Unexpected missing base AVPs: %v", missing)
+	}
+}
@@ -20,6 +20,8 @@ organized in sub-packages with specific functionality:
 
  * diam/dict: a dictionary parser that supports collections of dictionaries.
 
+ * diam/sessionid: Session-Id generators.
+
 If you're looking to go right into code, see the examples subdirectory for
 applications like clients and servers.
 
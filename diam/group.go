@@ -6,10 +6,11 @@ package diam
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // GroupedAVPType is the identifier of the GroupedAVP data type.
@@ -23,15 +24,21 @@ type GroupedAVP struct {
 
 // DecodeGrouped decodes a Grouped AVP from a datatype.Grouped (byte array).
 func DecodeGrouped(data datatype.Grouped, application uint32, dictionary *dict.Parser) (*GroupedAVP, error) {
+	return decodeGrouped(data, application, dictionary, nil)
+}
+
+// decodeGrouped decodes a Grouped AVP according to opts.
+func decodeGrouped(data datatype.Grouped, application uint32, dictionary *dict.Parser, opts *DecodeOptions) (*GroupedAVP, error) {
 	g := &GroupedAVP{}
 	b := []byte(data)
 	for n := 0; n < len(b); {
-		avp, err := DecodeAVP(b[n:], application, dictionary)
+		avp := &AVP{}
+		err := avp.decode(b[n:], application, dictionary, opts)
 		if err != nil {
-			return nil, err
+			return nil, newErrDecodeAVP(n, b[n:], err)
 		}
 		g.AVP = append(g.AVP, avp)
-		n += avp.Len()
+		n += avp.wireLen()
 	}
 	// TODO: handle nested groups?
 	return g, nil
@@ -48,6 +55,16 @@ func (g *GroupedAVP) Serialize() []byte {
 	return b
 }
 
+// Clone implements the datatype.Cloner interface. It returns a deep
+// copy of the GroupedAVP and its AVPs.
+func (g *GroupedAVP) Clone() datatype.Type {
+	c := &GroupedAVP{AVP: make([]*AVP, len(g.AVP))}
+	for n, a := range g.AVP {
+		c.AVP[n] = a.Clone()
+	}
+	return c
+}
+
 // Len implements the datatype.Type interface.
 func (g *GroupedAVP) Len() int {
 	var l int
@@ -83,3 +100,37 @@ func (g *GroupedAVP) String() string {
 func (g *GroupedAVP) AddAVP(a *AVP) {
 	g.AVP = append(g.AVP, a)
 }
+
+// FindAVP returns the first child AVP with the given code. It does not
+// search nested groups.
+func (g *GroupedAVP) FindAVP(code uint32) (*AVP, error) {
+	for _, a := range g.AVP {
+		if a.Code == code {
+			return a, nil
+		}
+	}
+	return nil, errors.New("Not found")
+}
+
+// Validate checks the children of the Grouped AVP identified by code
+// against the rules of that AVP in the dictionary, as Message.Validate
+// does for the AVPs of a message, including nested Grouped AVPs. AVPs
+// are matched to the rules by their code and vendor.
+//
+// Validate returns a *ValidationError for the first problem found,
+// with the name of the Grouped AVP as its path.
+func (g *GroupedAVP) Validate(code, application uint32, dictionary *dict.Parser) error {
+	dictAVP, err := dictionary.FindAVP(application, code)
+	if err != nil {
+		return err
+	}
+	var first *ValidationError
+	v := &validator{d: dictionary, appid: application, report: func(e *ValidationError) bool {
+		first = e
+		return false
+	}}
+	if v.validateAVPs(dictAVP.Name, dictAVP.Data.Rule, g.AVP) {
+		return nil
+	}
+	return first
+}
@@ -9,9 +9,9 @@ import (
 	"encoding/hex"
 	"testing"
 
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // testGroupedAVP is a Vendor-Specific-Application-Id Grouped AVP.
@@ -68,3 +68,115 @@ func TestMakeGroupedAVP(t *testing.T) {
 	}
 	t.Logf("Message:\n%s", a)
 }
+
+func TestGroupedAVP_FindAVP(t *testing.T) {
+	a, err := DecodeAVP(testGroupedAVP, 0, dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := a.Data.(*GroupedAVP)
+	vid, err := g.FindAVP(avp.VendorID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := vid.Data.(datatype.Unsigned32); v != 10415 {
+		t.Fatalf("Unexpected Vendor-Id. Want 10415, have %d", v)
+	}
+	if _, err = g.FindAVP(avp.AcctApplicationID); err == nil {
+		t.Fatal("Unexpected Acct-Application-Id found")
+	}
+}
+
+func TestGroupedAVP_Validate(t *testing.T) {
+	a, err := DecodeAVP(testGroupedAVP, 0, dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := a.Data.(*GroupedAVP)
+	if err = g.Validate(a.Code, 0, dict.Default); err != nil {
+		t.Fatal(err)
+	}
+	missing := &GroupedAVP{
+		AVP: []*AVP{
+			NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	err = missing.Validate(avp.VendorSpecificApplicationID, 0, dict.Default)
+	if e, ok := err.(*ValidationError); !ok || e.Code != MissingAVP ||
+		e.Message != "missing required AVP Vendor-Id" {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	g.AddAVP(NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(13)))
+	err = g.Validate(a.Code, 0, dict.Default)
+	if e, ok := err.(*ValidationError); !ok || e.Code != AVPOccursTooManyTimes ||
+		e.Message != "AVP Vendor-Id occurs 2 times, max 1" {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestGroupedAVP_ValidateMinForbidden(t *testing.T) {
+	b := dict.NewBuilder()
+	app := b.App(1000, "auth", "Test")
+	app.AVP("Test-Int", 65000, datatype.Integer32Type).Must("M")
+	app.AVP("Test-Old", 65001, datatype.Integer32Type).Must("M")
+	app.AVP("Test-Group", 65002, datatype.GroupedType).Must("M").Rule(
+		&dict.Rule{AVP: "Test-Int", Min: 2, Max: 3},
+		&dict.Rule{AVP: "Test-Old", Forbidden: true},
+	)
+	p, err := b.Parser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &GroupedAVP{
+		AVP: []*AVP{
+			NewAVP(65000, avp.Mbit, 0, datatype.Integer32(1)),
+		},
+	}
+	err = g.Validate(65002, 1000, p)
+	if e, ok := err.(*ValidationError); !ok || e.Code != MissingAVP ||
+		e.Message != "AVP Test-Int occurs 1 times, min 2" {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	g.AddAVP(NewAVP(65000, avp.Mbit, 0, datatype.Integer32(2)))
+	if err = g.Validate(65002, 1000, p); err != nil {
+		t.Fatal(err)
+	}
+	g.AddAVP(NewAVP(65001, avp.Mbit, 0, datatype.Integer32(3)))
+	err = g.Validate(65002, 1000, p)
+	if e, ok := err.(*ValidationError); !ok || e.Code != AVPNotAllowed {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := "Test-Group: AVP Test-Old is not allowed"; err.Error() != want {
+		t.Fatalf("Unexpected error. Want %q, have %q", want, err)
+	}
+}
+
+func TestGroupedAVP_ValidateVendor(t *testing.T) {
+	b := dict.NewBuilder()
+	app := b.App(1000, "auth", "Test")
+	app.AVP("Test-Int", 65000, datatype.Integer32Type).Must("M")
+	app.AVP("Vendor-Int", 65000, datatype.Integer32Type).Must("V").VendorID(99)
+	app.AVP("Test-Group", 65002, datatype.GroupedType).Must("M").Rule(
+		&dict.Rule{AVP: "Test-Int", Required: true, Max: 1},
+		&dict.Rule{AVP: "AVP"},
+	)
+	p, err := b.Parser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Vendor-Int has the code of Test-Int, but is a different AVP.
+	g := &GroupedAVP{
+		AVP: []*AVP{
+			NewAVP(65000, avp.Mbit, 0, datatype.Integer32(1)),
+			NewAVP(65000, avp.Vbit, 99, datatype.Integer32(2)),
+		},
+	}
+	if err = g.Validate(65002, 1000, p); err != nil {
+		t.Fatal(err)
+	}
+	g.AVP = g.AVP[1:]
+	err = g.Validate(65002, 1000, p)
+	if e, ok := err.(*ValidationError); !ok || e.Code != MissingAVP {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"net"
+	"strings"
+	"sync/atomic"
+)
+
+// ParseNetworks parses a list of networks in CIDR notation, such as
+// "192.0.2.0/24" or "2001:db8::/32", for use in Server.AllowNet and
+// Server.DenyNet. Plain IP addresses are parsed as single host networks.
+func ParseNetworks(cidrs ...string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, s := range cidrs {
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, &net.ParseError{Type: "IP address", Text: s}
+			}
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			bits := len(ip) * 8
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, ipnet)
+	}
+	return nets, nil
+}
+
+// Rejected returns the number of incoming connections rejected by the
+// server's AllowNet and DenyNet filters, or because the peer was in the
+// server's Blacklist.
+func (srv *Server) Rejected() uint64 {
+	return atomic.LoadUint64(&srv.rejected)
+}
+
+// allowed reports whether connections from addr pass the server's
+// AllowNet and DenyNet filters. Addresses without an IP, such as unix
+// sockets, are only accepted when no filter is set.
+func (srv *Server) allowed(addr net.Addr) bool {
+	if len(srv.AllowNet) == 0 && len(srv.DenyNet) == 0 {
+		return true
+	}
+	ip := addrIP(addr)
+	if ip == nil {
+		return false
+	}
+	if containsIP(srv.DenyNet, ip) {
+		return false
+	}
+	return len(srv.AllowNet) == 0 || containsIP(srv.AllowNet, ip)
+}
+
+// addrIP returns the IP address of addr, or nil if it has none.
+func addrIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		return a.IP
+	case *net.IPAddr:
+		return a.IP
+	case nil:
+		return nil
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+)
+
+func TestParseNetworks(t *testing.T) {
+	nets, err := diam.ParseNetworks("10.0.0.0/8", "192.0.2.1", "2001:db8::/32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nets) != 3 {
+		t.Fatalf("Unexpected # of networks. Want 3, have %d", len(nets))
+	}
+	if v := nets[1].String(); v != "192.0.2.1/32" {
+		t.Fatalf("Unexpected network. Want 192.0.2.1/32, have %s", v)
+	}
+	for _, s := range []string{"10.0.0.0/33", "not-an-ip"} {
+		if _, err := diam.ParseNetworks(s); err == nil {
+			t.Fatalf("Unexpected success parsing %q", s)
+		}
+	}
+}
+
+func TestServer_DenyNet(t *testing.T) {
+	srv := diamtest.NewUnstartedServer(diam.NewServeMux(), nil)
+	srv.Config.DenyNet, _ = diam.ParseNetworks("127.0.0.0/8")
+	srv.Start()
+	defer srv.Close()
+	c, err := net.Dial("tcp", srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err = c.Read(make([]byte, 1)); err == nil {
+		t.Fatal("Connection was not closed by the server")
+	}
+	if n := srv.Config.Rejected(); n != 1 {
+		t.Fatalf("Unexpected # of rejected connections. Want 1, have %d", n)
+	}
+}
+
+func TestServer_AllowNet(t *testing.T) {
+	errc := make(chan error, 1)
+	smux := diam.NewServeMux()
+	smux.Handle("CER", handleCER(errc, false))
+	srv := diamtest.NewUnstartedServer(smux, nil)
+	srv.Config.AllowNet, _ = diam.ParseNetworks("127.0.0.0/8", "::1")
+	srv.Start()
+	defer srv.Close()
+	wait := make(chan struct{})
+	cmux := diam.NewServeMux()
+	cmux.Handle("CEA", handleCEA(errc, wait))
+	cli, err := diam.Dial(srv.Address, cmux, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	sendCER(cli)
+	select {
+	case <-wait:
+	case err := <-errc:
+		t.Fatal(err)
+	case <-time.After(time.Second):
+		t.Fatal("Timed out: no CER or CEA received")
+	}
+	if n := srv.Config.Rejected(); n != 0 {
+		t.Fatalf("Unexpected # of rejected connections. Want 0, have %d", n)
+	}
+}
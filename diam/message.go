@@ -9,22 +9,90 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"math/rand"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
+	hopByHopID = rand.Uint32()
+}
+
+// hopByHopID is the last Hop-by-Hop Identifier allocated by NewMessage,
+// accessed atomically.
+var hopByHopID uint32
+
+// nextHopByHopID returns a new Hop-by-Hop Identifier. Identifiers are
+// monotonically increasing from a random start value, as recommended
+// by RFC 6733 section 3, and unique across goroutines.
+func nextHopByHopID() uint32 {
+	return atomic.AddUint32(&hopByHopID, 1)
 }
 
 // MessageBufferLength is the default buffer length for Diameter messages.
 var MessageBufferLength = 1 << 10
 
+// TrailingDataPolicy defines how to handle trailing data in messages.
+// See DecodeOptions.
+type TrailingDataPolicy int
+
+// Trailing data policies.
+const (
+	// RejectTrailingData fails to decode messages with trailing data.
+	RejectTrailingData TrailingDataPolicy = iota
+
+	// IgnoreTrailingData silently discards trailing data.
+	IgnoreTrailingData
+
+	// WarnTrailingData discards trailing data and logs a warning.
+	WarnTrailingData
+)
+
+// DecodeOptions are policies for decoding messages from peers that do
+// not follow the RFCs to the letter. The zero value, like a nil
+// *DecodeOptions, rejects such messages. See ReadMessageWithOptions.
+type DecodeOptions struct {
+	// TrailingData is the policy for handling trailing data in
+	// messages: bytes after the last AVP, within the message length,
+	// that are either shorter than an AVP header or all zeros, as sent
+	// by some peers that pad messages.
+	TrailingData TrailingDataPolicy
+
+	// DecodeOptions are the policies for decoding AVP data types.
+	datatype.DecodeOptions
+}
+
+// types returns the policies for decoding AVP data types, or nil for
+// the defaults.
+func (o *DecodeOptions) types() *datatype.DecodeOptions {
+	if o == nil {
+		return nil
+	}
+	return &o.DecodeOptions
+}
+
+// ErrTrailingData is returned when decoding a message with trailing
+// data, and DecodeOptions.TrailingData is RejectTrailingData.
+type ErrTrailingData struct {
+	Offset int // Offset of the trailing data from the start of the message
+	Length int // Length of the trailing data
+}
+
+// Error implements the error interface.
+func (e *ErrTrailingData) Error() string {
+	return fmt.Sprintf("Trailing data in message: %d bytes at offset %d",
+		e.Length, e.Offset)
+}
+
 // Message represents a Diameter message.
 type Message struct {
 	Header *Header
@@ -36,7 +104,18 @@ type Message struct {
 
 // ReadMessage returns a Message. It uses the dictionary to parse the
 // binary stream from the reader.
+//
+// When an AVP fails to decode, ReadMessage returns an *ErrDecodeAVP
+// along with the message, which has its header and the AVPs decoded
+// before the one that failed, so that the request can be answered.
+// The whole message has been read from the reader.
 func ReadMessage(reader io.Reader, dictionary *dict.Parser) (*Message, error) {
+	return ReadMessageWithOptions(reader, dictionary, nil)
+}
+
+// ReadMessageWithOptions is like ReadMessage, and decodes the message
+// according to opts. A nil opts uses the defaults.
+func ReadMessageWithOptions(reader io.Reader, dictionary *dict.Parser, opts *DecodeOptions) (*Message, error) {
 	buf := newReaderBuffer()
 	defer putReaderBuffer(buf)
 	m := &Message{dictionary: dictionary}
@@ -44,7 +123,10 @@ func ReadMessage(reader io.Reader, dictionary *dict.Parser) (*Message, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err = readAndParseBody(reader, buf, cmd, m); err != nil {
+	if err = readAndParseBody(reader, buf, cmd, m, opts); err != nil {
+		if _, ok := err.(*ErrDecodeAVP); ok {
+			return m, err
+		}
 		return nil, err
 	}
 	return m, nil
@@ -93,7 +175,11 @@ func readAndParseHeader(r io.Reader, buf *bytes.Buffer, m *Message) (cmd *dict.C
 	return cmd, nil
 }
 
-func readAndParseBody(r io.Reader, buf *bytes.Buffer, cmd *dict.Command, m *Message) error {
+func readAndParseBody(r io.Reader, buf *bytes.Buffer, cmd *dict.Command, m *Message, opts *DecodeOptions) error {
+	if m.Header.MessageLength < HeaderLength {
+		return fmt.Errorf("Invalid message length: %d < %d",
+			m.Header.MessageLength, HeaderLength)
+	}
 	b := readerBufferSlice(buf, int(m.Header.MessageLength-HeaderLength))
 	_, err := io.ReadFull(r, b)
 	if err != nil {
@@ -108,7 +194,7 @@ func readAndParseBody(r io.Reader, buf *bytes.Buffer, cmd *dict.Command, m *Mess
 	}
 	// Pre-allocate max # of AVPs for this message.
 	m.AVP = make([]*AVP, 0, n)
-	if err = decodeAVPs(m, b); err != nil {
+	if err = decodeAVPs(m, b, opts); err != nil {
 		return err
 	}
 	return nil
@@ -121,25 +207,61 @@ func maxAVPs(m *Message, cmd *dict.Command) int {
 	return len(cmd.Answer.Rule)
 }
 
-func decodeAVPs(m *Message, pbytes []byte) error {
-	var a *AVP
-	var err error
+func decodeAVPs(m *Message, pbytes []byte, opts *DecodeOptions) error {
 	for n := 0; n < len(pbytes); {
-		a, err = DecodeAVP(pbytes[n:],
-			m.Header.ApplicationID, m.Dictionary())
+		if isTrailingData(pbytes[n:]) {
+			return trailingData(m, HeaderLength+n, len(pbytes)-n, opts)
+		}
+		a := &AVP{}
+		err := a.decode(pbytes[n:],
+			m.Header.ApplicationID, m.Dictionary(), opts)
 		if err != nil {
-			return fmt.Errorf("Failed to decode AVP: %s", err)
+			return newErrDecodeAVP(HeaderLength+n, pbytes[n:], err)
 		}
 		m.AVP = append(m.AVP, a)
-		n += a.Len()
+		n += a.wireLen()
 	}
 	return nil
 }
 
-// NewMessage creates and initializes a Message.
+// isTrailingData reports whether b, the remaining bytes of a message
+// after an AVP, is trailing data rather than another AVP.
+func isTrailingData(b []byte) bool {
+	if len(b) < 8 {
+		return true
+	}
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// trailingData handles trailing data according to the TrailingData
+// policy of opts.
+func trailingData(m *Message, offset, length int, opts *DecodeOptions) error {
+	var policy TrailingDataPolicy
+	if opts != nil {
+		policy = opts.TrailingData
+	}
+	switch policy {
+	case IgnoreTrailingData:
+		return nil
+	case WarnTrailingData:
+		log.Printf("diam: discarding %d bytes of trailing data at offset %d in %s",
+			length, offset, m.Header)
+		return nil
+	}
+	return &ErrTrailingData{Offset: offset, Length: length}
+}
+
+// NewMessage creates and initializes a Message. When hopbyhop is zero
+// a new unique Hop-by-Hop Identifier is allocated, and when endtoend is
+// zero a random End-to-End Identifier is used.
 func NewMessage(cmd uint32, flags uint8, appid, hopbyhop, endtoend uint32, dictionary *dict.Parser) *Message {
 	if hopbyhop == 0 {
-		hopbyhop = rand.Uint32()
+		hopbyhop = nextHopByHopID()
 	}
 	if endtoend == 0 {
 		endtoend = rand.Uint32()
@@ -168,14 +290,57 @@ func NewRequest(cmd uint32, appid uint32, dictionary *dict.Parser) *Message {
 // If no dictionary is associated then it returns the default dictionary.
 func (m *Message) Dictionary() *dict.Parser {
 	if m.dictionary == nil {
-		return dict.Default
+		return dict.DefaultParser()
 	}
 	return m.dictionary
 }
 
+// Clone returns a deep copy of the Message and its AVPs, associated
+// with the same dictionary.
+func (m *Message) Clone() *Message {
+	c := &Message{dictionary: m.dictionary}
+	if m.Header != nil {
+		h := *m.Header
+		c.Header = &h
+	}
+	if m.AVP != nil {
+		c.AVP = make([]*AVP, len(m.AVP))
+		for n, a := range m.AVP {
+			c.AVP[n] = a.Clone()
+		}
+	}
+	return c
+}
+
+// Equal reports whether m and o have the same header and the same
+// AVPs in the same order, as compared by AVP.Equal.
+func (m *Message) Equal(o *Message) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	if (m.Header == nil) != (o.Header == nil) ||
+		m.Header != nil && *m.Header != *o.Header {
+		return false
+	}
+	if len(m.AVP) != len(o.AVP) {
+		return false
+	}
+	for n, a := range m.AVP {
+		if !a.Equal(o.AVP[n]) {
+			return false
+		}
+	}
+	return true
+}
+
 // NewAVP creates and initializes a new AVP and adds it to the Message.
+// If data is datatype.Repeated, such as datatype.Unsigned32List, one AVP
+// is added per value and the last one is returned.
 // It is not safe for concurrent calls.
 func (m *Message) NewAVP(code interface{}, flags uint8, vendor uint32, data datatype.Type) (*AVP, error) {
+	if r, ok := data.(datatype.Repeated); ok {
+		return m.newRepeatedAVP(code, flags, vendor, r)
+	}
 	var a *AVP
 	switch code.(type) {
 	case int:
@@ -197,6 +362,23 @@ func (m *Message) NewAVP(code interface{}, flags uint8, vendor uint32, data data
 	return a, nil
 }
 
+// newRepeatedAVP adds one AVP per value of r to the Message, and returns
+// the last one. It returns an error if r has no values.
+func (m *Message) newRepeatedAVP(code interface{}, flags uint8, vendor uint32, r datatype.Repeated) (*AVP, error) {
+	values := r.Values()
+	if len(values) == 0 {
+		return nil, fmt.Errorf("No values in %s", r)
+	}
+	var a *AVP
+	var err error
+	for _, v := range values {
+		if a, err = m.NewAVP(code, flags, vendor, v); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
 // AddAVP adds the AVP to the Message. It is not safe for concurrent calls.
 func (m *Message) AddAVP(a *AVP) {
 	m.AVP = append(m.AVP, a)
@@ -298,12 +480,136 @@ func (m *Message) FindAVP(code interface{}) (*AVP, error) {
 	return nil, errors.New("Not found")
 }
 
+// FindAVPs returns all AVPs of the Message with the given code, in
+// order. The code can be either the AVP code (int, uint32) or name
+// (string), and AVPs must also have the vendor of the AVP in the
+// dictionary. It returns no AVPs and no error when there are none.
+func (m *Message) FindAVPs(code interface{}) ([]*AVP, error) {
+	dictAVP, err := m.Dictionary().FindAVP(m.Header.ApplicationID, code)
+	if err != nil {
+		return nil, err
+	}
+	return findAVPs(m.AVP, dictAVP), nil
+}
+
+// findAVPs returns the AVPs of the list with the code and vendor of the
+// dictionary AVP.
+func findAVPs(list []*AVP, dictAVP *dict.AVP) []*AVP {
+	var avps []*AVP
+	for _, a := range list {
+		if a.Code == dictAVP.Code && a.VendorID == dictAVP.VendorID {
+			avps = append(avps, a)
+		}
+	}
+	return avps
+}
+
+// Lookup returns the AVPs at the given path of AVP names separated by
+// slashes, looking into Grouped AVPs. For example:
+//
+//	avps, err := m.Lookup("Multiple-Services-Credit-Control/Granted-Service-Unit/CC-Total-Octets")
+//
+// returns the CC-Total-Octets AVPs of the Granted-Service-Unit of all
+// Multiple-Services-Credit-Control AVPs of the message. A name may be
+// followed by an index, starting at 1, to select one of the AVPs with
+// that name in the message or in each Grouped AVP, like in
+// "Multiple-Services-Credit-Control[2]/Rating-Group".
+//
+// Names are resolved with the dictionary of the message, and AVPs are
+// matched by the code and vendor of the AVP in the dictionary. Lookup
+// returns no AVPs and no error when there are none, and an error when
+// the path is invalid, a name is not in the dictionary or an AVP in the
+// path is not Grouped.
+func (m *Message) Lookup(path string) ([]*AVP, error) {
+	steps := strings.Split(path, "/")
+	lists := [][]*AVP{m.AVP}
+	for n, step := range steps {
+		name, index, err := parsePathStep(step)
+		if err != nil {
+			return nil, err
+		}
+		dictAVP, err := m.Dictionary().FindAVP(m.Header.ApplicationID, name)
+		if err != nil {
+			return nil, err
+		}
+		var found []*AVP
+		for _, list := range lists {
+			avps := findAVPs(list, dictAVP)
+			if index > 0 {
+				if index > len(avps) {
+					continue
+				}
+				avps = avps[index-1 : index]
+			}
+			found = append(found, avps...)
+		}
+		if len(found) == 0 {
+			return nil, nil
+		}
+		if n == len(steps)-1 {
+			return found, nil
+		}
+		lists = lists[:0]
+		for _, a := range found {
+			g, ok := a.Data.(*GroupedAVP)
+			if !ok {
+				return nil, fmt.Errorf("AVP %s is not Grouped", name)
+			}
+			lists = append(lists, g.AVP)
+		}
+	}
+	return nil, nil
+}
+
+// parsePathStep parses a step of a path for Lookup, an AVP name with an
+// optional index such as "Rating-Group" or "Rating-Group[2]". The index
+// is 0 when not set.
+func parsePathStep(step string) (name string, index int, err error) {
+	i := strings.IndexByte(step, '[')
+	if i < 0 {
+		return step, 0, nil
+	}
+	if !strings.HasSuffix(step, "]") {
+		return "", 0, fmt.Errorf("Invalid path step: %q", step)
+	}
+	index, err = strconv.Atoi(step[i+1 : len(step)-1])
+	if err != nil || index < 1 {
+		return "", 0, fmt.Errorf("Invalid index in path step: %q", step)
+	}
+	return step[:i], index, nil
+}
+
+// Query returns the data of the AVPs at the given path, as typed values
+// of the datatype package, or *GroupedAVP for Grouped AVPs. The path
+// is the same as in Lookup. For example:
+//
+//	v, err := m.Query("Multiple-Services-Credit-Control[1]/Requested-Service-Unit/CC-Total-Octets")
+//	if err == nil && len(v) > 0 {
+//		octets := v[0].(datatype.Unsigned64)
+//	}
+func (m *Message) Query(path string) ([]datatype.Type, error) {
+	avps, err := m.Lookup(path)
+	if err != nil {
+		return nil, err
+	}
+	var values []datatype.Type
+	for _, a := range avps {
+		values = append(values, a.Data)
+	}
+	return values, nil
+}
+
 // Answer creates an answer for the current Message with an embedded
-// Result-Code AVP.
+// Result-Code AVP. The E-bit of the answer is set for protocol errors,
+// Result-Codes in the 3xxx range, as required by RFC 6733 section 7.1.3.
 func (m *Message) Answer(resultCode uint32) *Message {
+	flags := m.Header.CommandFlags &^ RequestFlag // Reset the Request bit.
+	if resultCode >= 3000 && resultCode < 4000 {
+		flags |= ErrorFlag
+	}
 	nm := NewMessage(
 		m.Header.CommandCode,
-		m.Header.CommandFlags&^RequestFlag, // Reset the Request bit.
+		flags,
 		m.Header.ApplicationID,
 		m.Header.HopByHopID,
 		m.Header.EndToEndID,
@@ -313,6 +619,81 @@ func (m *Message) Answer(resultCode uint32) *Message {
 	return nm
 }
 
+// AnswerOptions are the AVPs AnswerWith adds to answers.
+type AnswerOptions struct {
+	// SessionID copies the Session-Id of the request, if any, as the
+	// first AVP of the answer.
+	SessionID bool
+
+	// ProxyInfo copies the Proxy-Info AVPs of the request, in order,
+	// as required of answers by RFC 6733 section 6.2.
+	ProxyInfo bool
+
+	// OriginHost and OriginRealm are added as Origin-Host and
+	// Origin-Realm when not empty.
+	OriginHost  datatype.DiameterIdentity
+	OriginRealm datatype.DiameterIdentity
+}
+
+// AnswerWith creates an answer like Answer does, with the AVPs of opts
+// added after the Result-Code. A nil opts adds no AVPs.
+func (m *Message) AnswerWith(resultCode uint32, opts *AnswerOptions) *Message {
+	a := m.Answer(resultCode)
+	if opts == nil {
+		return a
+	}
+	if opts.SessionID {
+		if sid, err := m.FindAVP(avp.SessionID); err == nil {
+			a.InsertAVP(sid)
+		}
+	}
+	if opts.OriginHost != "" {
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, opts.OriginHost)
+	}
+	if opts.OriginRealm != "" {
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, opts.OriginRealm)
+	}
+	if opts.ProxyInfo {
+		for _, pi := range m.AVP {
+			if pi.Code == avp.ProxyInfo {
+				a.AddAVP(pi)
+			}
+		}
+	}
+	return a
+}
+
+// ErrorAnswer creates the answer to the request m for the error e,
+// from the host originHost of realm originRealm. Per RFC 6733 section
+// 7, the answer has:
+//
+//   - the Session-Id of the request, if any, as its first AVP
+//   - the Result-Code of e, and the E-bit for protocol errors (3xxx)
+//   - Origin-Host and Origin-Realm
+//   - the Proxy-Info AVPs of the request
+//   - Error-Message, when e has a message
+//   - Error-Reporting-Host, when e has a reporting host other than
+//     originHost
+//   - Failed-AVP, a Grouped AVP with the failed AVPs of e, if any
+func (m *Message) ErrorAnswer(e *ResultError, originHost, originRealm datatype.DiameterIdentity) *Message {
+	a := m.AnswerWith(e.Code, &AnswerOptions{
+		SessionID:   true,
+		ProxyInfo:   true,
+		OriginHost:  originHost,
+		OriginRealm: originRealm,
+	})
+	if e.Message != "" {
+		a.NewAVP(avp.ErrorMessage, 0, 0, datatype.UTF8String(e.Message))
+	}
+	if e.ReportingHost != "" && e.ReportingHost != originHost {
+		a.NewAVP(avp.ErrorReportingHost, 0, 0, e.ReportingHost)
+	}
+	if len(e.FailedAVP) > 0 {
+		a.NewAVP(avp.FailedAVP, avp.Mbit, 0, &GroupedAVP{AVP: e.FailedAVP})
+	}
+	return a
+}
+
 func (m *Message) String() string {
 	var b bytes.Buffer
 	var typ string
@@ -336,9 +717,10 @@ func (m *Message) String() string {
 		)
 	}
 	for _, a := range m.AVP {
-		if dictAVP, err := m.Dictionary().FindAVP(
+		if dictAVP, err := m.Dictionary().FindAVPWithVendor(
 			m.Header.ApplicationID,
 			a.Code,
+			a.VendorID,
 		); err != nil {
 			fmt.Fprintf(&b, "\tUnknown %s (%s)\n", a, err)
 		} else if a.Data.Type() == GroupedAVPType {
@@ -359,9 +741,10 @@ func printGrouped(prefix string, m *Message, a *AVP, indent int) string {
 		a.VendorID,
 	)
 	for _, ga := range a.Data.(*GroupedAVP).AVP {
-		if dictAVP, err := m.Dictionary().FindAVP(
+		if dictAVP, err := m.Dictionary().FindAVPWithVendor(
 			m.Header.ApplicationID,
 			ga.Code,
+			ga.VendorID,
 		); err != nil {
 			fmt.Fprintf(&b, "%s\tUnknown %s (%s),\n", prefix, ga, err)
 		} else {
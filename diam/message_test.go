@@ -9,11 +9,12 @@ import (
 	"encoding/hex"
 	"io/ioutil"
 	"net"
+	"strings"
 	"testing"
 
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // testMessage is used by the test cases below and also in reflect_test.go.
@@ -90,6 +91,62 @@ var testMessage = []byte{
 	0x00, 0x00, 0x00, 0x01,
 }
 
+// testMessageIPv6 is a CER from an IPv6-only peer, advertising two
+// IPv6 addresses in Host-IP-Address.
+//
+// Capabilities-Exchange-Request (CER)
+// {Code:257,Flags:0x80,Version:0x1,Length:160,ApplicationId:0,HopByHopId:0x1b0e5a71,EndToEndId:0x6c2f0a93}
+//   Origin-Host {Code:264,Flags:0x40,Length:16,VendorId:0,Value:DiameterIdentity{peer6},Padding:3}
+//   Origin-Realm {Code:296,Flags:0x40,Length:16,VendorId:0,Value:DiameterIdentity{ipv6.net},Padding:0}
+//   Host-IP-Address {Code:257,Flags:0x40,Length:28,VendorId:0,Value:Address{2001:db8::1},Padding:2}
+//   Host-IP-Address {Code:257,Flags:0x40,Length:28,VendorId:0,Value:Address{fe80::21b:21ff:fe3a:5c1},Padding:2}
+//   Vendor-Id {Code:266,Flags:0x40,Length:12,VendorId:0,Value:Unsigned32{10415}}
+//   Product-Name {Code:269,Flags:0x0,Length:16,VendorId:0,Value:UTF8String{peer6},Padding:3}
+//   Origin-State-Id {Code:278,Flags:0x40,Length:12,VendorId:0,Value:Unsigned32{1}}
+//   Auth-Application-Id {Code:258,Flags:0x40,Length:12,VendorId:0,Value:Unsigned32{4}}
+var testMessageIPv6 = []byte{
+	0x01, 0x00, 0x00, 0xa0,
+	0x80, 0x00, 0x01, 0x01,
+	0x00, 0x00, 0x00, 0x00,
+	0x1b, 0x0e, 0x5a, 0x71,
+	0x6c, 0x2f, 0x0a, 0x93,
+	0x00, 0x00, 0x01, 0x08,
+	0x40, 0x00, 0x00, 0x0d,
+	0x70, 0x65, 0x65, 0x72,
+	0x36, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x01, 0x28,
+	0x40, 0x00, 0x00, 0x10,
+	0x69, 0x70, 0x76, 0x36,
+	0x2e, 0x6e, 0x65, 0x74,
+	0x00, 0x00, 0x01, 0x01,
+	0x40, 0x00, 0x00, 0x1a,
+	0x00, 0x02, 0x20, 0x01,
+	0x0d, 0xb8, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00,
+	0x00, 0x01, 0x00, 0x00,
+	0x00, 0x00, 0x01, 0x01,
+	0x40, 0x00, 0x00, 0x1a,
+	0x00, 0x02, 0xfe, 0x80,
+	0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x02, 0x1b,
+	0x21, 0xff, 0xfe, 0x3a,
+	0x05, 0xc1, 0x00, 0x00,
+	0x00, 0x00, 0x01, 0x0a,
+	0x40, 0x00, 0x00, 0x0c,
+	0x00, 0x00, 0x28, 0xaf,
+	0x00, 0x00, 0x01, 0x0d,
+	0x00, 0x00, 0x00, 0x0d,
+	0x70, 0x65, 0x65, 0x72,
+	0x36, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x01, 0x16,
+	0x40, 0x00, 0x00, 0x0c,
+	0x00, 0x00, 0x00, 0x01,
+	0x00, 0x00, 0x01, 0x02,
+	0x40, 0x00, 0x00, 0x0c,
+	0x00, 0x00, 0x00, 0x04,
+}
+
 func TestReadMessage(t *testing.T) {
 	msg, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
 	if err != nil {
@@ -98,6 +155,220 @@ func TestReadMessage(t *testing.T) {
 	t.Logf("Message:\n%s", msg)
 }
 
+func TestReadMessageIPv6(t *testing.T) {
+	msg, err := ReadMessage(bytes.NewReader(testMessageIPv6), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var addrs []string
+	for _, a := range msg.AVP {
+		if a.Code != avp.HostIPAddress {
+			continue
+		}
+		addr, ok := a.Data.(datatype.Address)
+		if !ok {
+			t.Fatalf("Unexpected Host-IP-Address data: %#v", a.Data)
+		}
+		addrs = append(addrs, net.IP(addr).String())
+	}
+	want := []string{"2001:db8::1", "fe80::21b:21ff:fe3a:5c1"}
+	if len(addrs) != len(want) || addrs[0] != want[0] || addrs[1] != want[1] {
+		t.Fatalf("Unexpected addresses. Want %v, have %v", want, addrs)
+	}
+	b, err := msg.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, testMessageIPv6) {
+		t.Fatalf("Unexpected message.\nWant:\n%s\nHave:\n%s",
+			hex.Dump(testMessageIPv6), hex.Dump(b))
+	}
+}
+
+func TestReadMessageInvalidLength(t *testing.T) {
+	b := make([]byte, len(testMessage))
+	copy(b, testMessage)
+	b[3] = 0x04 // Message length shorter than the header.
+	if _, err := ReadMessage(bytes.NewReader(b), dict.Default); err == nil {
+		t.Fatal("Message with invalid length decoded with no error")
+	}
+}
+
+func TestReadMessageInvalidAVPLength(t *testing.T) {
+	b := make([]byte, len(testMessage))
+	copy(b, testMessage)
+	b[59] = 0x04 // Length of Host-IP-Address, at offset 52.
+	m, err := ReadMessage(bytes.NewReader(b), dict.Default)
+	e, ok := err.(*ErrDecodeAVP)
+	if !ok {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e.Offset != 52 {
+		t.Fatalf("Unexpected offset. Want 52, have %d", e.Offset)
+	}
+	if !bytes.Equal(e.AVP, b[52:60]) {
+		t.Fatalf("Unexpected AVP bytes. Want header only, have %x", e.AVP)
+	}
+	if code := e.ResultCode(); code != InvalidAVPLenght {
+		t.Fatalf("Unexpected Result-Code. Want %d, have %d", InvalidAVPLenght, code)
+	}
+	if m == nil || len(m.AVP) != 2 {
+		t.Fatalf("Unexpected message: %v", m)
+	}
+}
+
+func TestReadMessageUnknownAVP(t *testing.T) {
+	b := make([]byte, len(testMessage))
+	copy(b, testMessage)
+	b[54], b[55] = 0x27, 0x0f // Host-IP-Address is now AVP 9999.
+	_, err := ReadMessage(bytes.NewReader(b), dict.Default)
+	e, ok := err.(*ErrDecodeAVP)
+	if !ok {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !bytes.Equal(e.AVP, b[52:66]) {
+		t.Fatalf("Unexpected AVP bytes. Want %x, have %x", b[52:66], e.AVP)
+	}
+	if code := e.ResultCode(); code != AVPUnsupported {
+		t.Fatalf("Unexpected Result-Code. Want %d, have %d", AVPUnsupported, code)
+	}
+	a := e.FailedAVP()
+	if a.Code != 9999 || a.Flags != avp.Mbit {
+		t.Fatalf("Unexpected Failed-AVP: %s", a)
+	}
+	f, err := a.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(f[:14], b[52:66]) {
+		t.Fatalf("Unexpected Failed-AVP.\nWant: %x\nHave: %x", b[52:66], f[:14])
+	}
+}
+
+func TestReadMessageUnknownAVPNotMandatory(t *testing.T) {
+	b := make([]byte, len(testMessage))
+	copy(b, testMessage)
+	b[54], b[55] = 0x27, 0x0f // Host-IP-Address is now AVP 9999.
+	b[56] = 0                 // without the M-bit.
+	m, err := ReadMessage(bytes.NewReader(b), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := m.AVP[2]
+	if a.Code != 9999 {
+		t.Fatalf("Unexpected AVP. Want 9999, have %d", a.Code)
+	}
+	if v, ok := a.Data.(datatype.OctetString); !ok || !bytes.Equal([]byte(v), b[60:66]) {
+		t.Fatalf("Unexpected value. Want OctetString{%x}, have %v", b[60:66], a.Data)
+	}
+}
+
+func TestReadMessageTrailingData(t *testing.T) {
+	for _, trailer := range [][]byte{
+		{0x00, 0x00, 0x00, 0x00},
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	} {
+		b := make([]byte, len(testMessage)+len(trailer))
+		copy(b, testMessage)
+		copy(b[len(testMessage):], trailer)
+		b[3] += byte(len(trailer)) // Message length.
+		_, err := ReadMessage(bytes.NewReader(b), dict.Default)
+		e, ok := err.(*ErrTrailingData)
+		if !ok {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if e.Offset != len(testMessage) || e.Length != len(trailer) {
+			t.Fatalf("Unexpected trailing data. Want %d bytes at offset %d, have %d at %d",
+				len(trailer), len(testMessage), e.Length, e.Offset)
+		}
+		opts := &DecodeOptions{TrailingData: IgnoreTrailingData}
+		m, err := ReadMessageWithOptions(bytes.NewReader(b), dict.Default, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(m.AVP) != 12 {
+			t.Fatalf("Unexpected # of AVPs. Want 12, have %d", len(m.AVP))
+		}
+	}
+}
+
+func TestReadMessageWithOptions(t *testing.T) {
+	m := NewRequest(CapabilitiesExchange, 0, dict.Default)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("test"))
+	m.NewAVP(avp.ProductName, 0, 0, datatype.UTF8String("go\xffdiameter"))
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ReadMessage(bytes.NewReader(b), dict.Default); err == nil {
+		t.Fatal("Invalid UTF-8 was decoded with the default options")
+	}
+	opts := &DecodeOptions{}
+	opts.UTF8 = datatype.ReplaceInvalidUTF8
+	m, err = ReadMessageWithOptions(bytes.NewReader(b), dict.Default, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := datatype.UTF8String("go\ufffddiameter")
+	if v := m.AVP[1].Data; v != want {
+		t.Fatalf("Unexpected value. Want %s, have %s", want, v)
+	}
+	mr, err := NewMessageReader(bytes.NewReader(b), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mr.Options = opts
+	if m, err = mr.Message(); err != nil {
+		t.Fatal(err)
+	}
+	if v := m.AVP[1].Data; v != want {
+		t.Fatalf("Unexpected value. Want %s, have %s", want, v)
+	}
+}
+
+func TestReadMessageAddressNotShared(t *testing.T) {
+	b := make([]byte, len(testMessage))
+	copy(b, testMessage)
+	n := bytes.Index(b, []byte{0x00, 0x01, 0x0a, 0x01, 0x00, 0x01})
+	copy(b[n+2:], []byte{0xc0, 0x00, 0x02, 0x01})
+	m, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ReadMessage(bytes.NewReader(b), dict.Default); err != nil {
+		t.Fatal(err)
+	}
+	a, err := m.FindAVP(avp.HostIPAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip := net.IP(a.Data.(datatype.Address)); !ip.Equal(net.ParseIP("10.1.0.1")) {
+		t.Fatalf("Unexpected address. Want 10.1.0.1, have %s", ip)
+	}
+}
+
+func TestDecodeAVPsIPv4MappedAddress(t *testing.T) {
+	b := []byte{
+		0x00, 0x00, 0x01, 0x01, // Host-IP-Address
+		0x40, 0x00, 0x00, 0x1a,
+		0x00, 0x02, 0x00, 0x00, // ::ffff:10.0.0.1
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0x0a, 0x00,
+		0x00, 0x01, 0x00, 0x00,
+		0x00, 0x00, 0x01, 0x0a, // Vendor-Id
+		0x40, 0x00, 0x00, 0x0c,
+		0x00, 0x00, 0x00, 0x0d,
+	}
+	m := NewRequest(CapabilitiesExchange, 0, dict.Default)
+	if err := decodeAVPs(m, b, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.AVP) != 2 || m.AVP[1].Code != avp.VendorID {
+		t.Fatalf("Unexpected AVPs: %v", m.AVP)
+	}
+}
+
 func TestNewMessage(t *testing.T) {
 	want, _ := ReadMessage(bytes.NewReader(testMessage), dict.Default)
 	m := NewMessage(CapabilitiesExchange, RequestFlag, 0, 0xa8cc407d, 0xa8c1b2b4, dict.Default)
@@ -135,6 +406,61 @@ func TestNewMessage(t *testing.T) {
 	t.Logf("Message:\n%s", hex.Dump(a))
 }
 
+func TestNewMessageRepeatedAVP(t *testing.T) {
+	m := NewMessage(CapabilitiesExchange, RequestFlag, 0, 0, 0, dict.Default)
+	vendors := datatype.Unsigned32List{10415, 13}
+	a, err := m.NewAVP(avp.SupportedVendorID, avp.Mbit, 0, vendors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := a.Data.(datatype.Unsigned32); v != 13 {
+		t.Fatalf("Unexpected value. Want 13, have %d", v)
+	}
+	if len(m.AVP) != 2 {
+		t.Fatalf("Unexpected number of AVPs. Want 2, have %d", len(m.AVP))
+	}
+	if want := uint32(HeaderLength + 24); m.Header.MessageLength != want {
+		t.Fatalf("Unexpected message length. Want %d, have %d", want, m.Header.MessageLength)
+	}
+	a, err = m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32List{})
+	if err == nil || a != nil || len(m.AVP) != 2 {
+		t.Fatalf("Unexpected AVP for empty list: %v, %v", a, err)
+	}
+}
+
+func TestAVPRepeatedSerialize(t *testing.T) {
+	a := NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32List{1, 2})
+	want := []byte{
+		0x00, 0x00, 0x01, 0x02, 0x40, 0x00, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x01,
+		0x00, 0x00, 0x01, 0x02, 0x40, 0x00, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x02,
+	}
+	b, err := a.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, want) || a.Len() != len(want) {
+		t.Fatalf("Unexpected AVP.\nWant:\n%s\nHave:\n%s", hex.Dump(want), hex.Dump(b))
+	}
+	m := NewRequest(CapabilitiesExchange, 0, dict.Default)
+	m.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &GroupedAVP{
+		AVP: []*AVP{a},
+	})
+	if b, err = m.Serialize(); err != nil {
+		t.Fatal(err)
+	}
+	m, err = ReadMessage(bytes.NewReader(b), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := m.AVP[0].Data.(*GroupedAVP); len(g.AVP) != 2 {
+		t.Fatalf("Unexpected grouped AVP: %s", g)
+	}
+	a = NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32List{})
+	if _, err = a.Serialize(); err == nil {
+		t.Fatal("Empty list was serialized")
+	}
+}
+
 func TestMessageFindAVP(t *testing.T) {
 	m, _ := ReadMessage(bytes.NewReader(testMessage), dict.Default)
 	a, err := m.FindAVP(avp.OriginStateID)
@@ -148,6 +474,211 @@ func TestMessageFindAVP(t *testing.T) {
 	t.Log(a)
 }
 
+func TestMessageAnswerWith(t *testing.T) {
+	m := NewRequest(CreditControl, 4, dict.Default)
+	m.NewAVP(avp.ProxyInfo, avp.Mbit, 0, &GroupedAVP{AVP: []*AVP{
+		NewAVP(avp.ProxyHost, avp.Mbit, 0, datatype.DiameterIdentity("p1")),
+	}})
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("sid"))
+	m.NewAVP(avp.ProxyInfo, avp.Mbit, 0, &GroupedAVP{AVP: []*AVP{
+		NewAVP(avp.ProxyHost, avp.Mbit, 0, datatype.DiameterIdentity("p2")),
+	}})
+	a := m.AnswerWith(Success, &AnswerOptions{
+		SessionID:   true,
+		ProxyInfo:   true,
+		OriginHost:  "server",
+		OriginRealm: "test",
+	})
+	if a.Header.CommandFlags != 0 {
+		t.Fatalf("Unexpected flags. Want 0, have %#x", a.Header.CommandFlags)
+	}
+	want := []uint32{
+		avp.SessionID,
+		avp.ResultCode,
+		avp.OriginHost,
+		avp.OriginRealm,
+		avp.ProxyInfo,
+		avp.ProxyInfo,
+	}
+	if len(a.AVP) != len(want) {
+		t.Fatalf("Unexpected answer: %s", a)
+	}
+	for n, code := range want {
+		if a.AVP[n].Code != code {
+			t.Fatalf("Unexpected AVP #%d. Want %d, have %d", n, code, a.AVP[n].Code)
+		}
+	}
+	if !a.AVP[4].Equal(m.AVP[0]) || !a.AVP[5].Equal(m.AVP[2]) {
+		t.Fatalf("Unexpected Proxy-Info: %s", a)
+	}
+	if a.Header.MessageLength != uint32(a.Len()) {
+		t.Fatalf("Unexpected length. Want %d, have %d", a.Len(), a.Header.MessageLength)
+	}
+	a = m.AnswerWith(UnableToDeliver, &AnswerOptions{})
+	if a.Header.CommandFlags != ErrorFlag || len(a.AVP) != 1 {
+		t.Fatalf("Unexpected answer: %s", a)
+	}
+	a = m.AnswerWith(UnableToDeliver, nil)
+	if a.Header.CommandFlags != ErrorFlag || len(a.AVP) != 1 {
+		t.Fatalf("Unexpected answer: %s", a)
+	}
+}
+
+func TestMessageErrorAnswer(t *testing.T) {
+	m := NewRequest(CreditControl, 4, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("sid"))
+	failed := NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(9))
+	m.AddAVP(failed)
+	a := m.ErrorAnswer(&ResultError{
+		Code:          InvalidAVPValue,
+		Message:       "bad request type",
+		FailedAVP:     []*AVP{failed},
+		ReportingHost: "backend",
+	}, "relay", "test")
+	b, err := a.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err = ReadMessage(bytes.NewReader(b), dict.Default); err != nil {
+		t.Fatal(err)
+	}
+	if a.Header.CommandFlags != 0 {
+		t.Fatalf("Unexpected flags. Want 0, have %#x", a.Header.CommandFlags)
+	}
+	want := []uint32{
+		avp.SessionID,
+		avp.ResultCode,
+		avp.OriginHost,
+		avp.OriginRealm,
+		avp.ErrorMessage,
+		avp.ErrorReportingHost,
+		avp.FailedAVP,
+	}
+	if len(a.AVP) != len(want) {
+		t.Fatalf("Unexpected answer: %s", a)
+	}
+	for n, code := range want {
+		if a.AVP[n].Code != code {
+			t.Fatalf("Unexpected AVP #%d. Want %d, have %d", n, code, a.AVP[n].Code)
+		}
+	}
+	g := a.AVP[6].Data.(*GroupedAVP)
+	if len(g.AVP) != 1 || !g.AVP[0].Equal(failed) {
+		t.Fatalf("Unexpected Failed-AVP: %s", a.AVP[6])
+	}
+	a = m.ErrorAnswer(&ResultError{Code: UnableToDeliver, ReportingHost: "relay"}, "relay", "test")
+	if a.Header.CommandFlags != ErrorFlag || len(a.AVP) != 4 {
+		t.Fatalf("Unexpected answer: %s", a)
+	}
+}
+
+func TestMessageFindAVPs(t *testing.T) {
+	m, _ := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	avps, err := m.FindAVPs("Supported-Vendor-Id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(avps) != 2 || avps[0].Data != datatype.Unsigned32(10415) || avps[1].Data != datatype.Unsigned32(13) {
+		t.Fatalf("Unexpected AVPs: %v", avps)
+	}
+	if avps, err = m.FindAVPs(avp.SessionID); err != nil || avps != nil {
+		t.Fatalf("Unexpected AVPs: %v, %v", avps, err)
+	}
+}
+
+func TestMessageLookup(t *testing.T) {
+	m := NewRequest(CreditControl, 4, dict.Default)
+	for _, octets := range []uint64{100, 200} {
+		m.NewAVP(avp.MultipleServicesCreditControl, avp.Mbit, 0, &GroupedAVP{
+			AVP: []*AVP{
+				NewAVP(avp.GrantedServiceUnit, avp.Mbit, 0, &GroupedAVP{
+					AVP: []*AVP{
+						NewAVP(avp.CCTotalOctets, avp.Mbit, 0, datatype.Unsigned64(octets)),
+					},
+				}),
+			},
+		})
+	}
+	avps, err := m.Lookup("Multiple-Services-Credit-Control/Granted-Service-Unit/CC-Total-Octets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(avps) != 2 || avps[0].Data != datatype.Unsigned64(100) || avps[1].Data != datatype.Unsigned64(200) {
+		t.Fatalf("Unexpected AVPs: %v", avps)
+	}
+	avps, err = m.Lookup("Multiple-Services-Credit-Control/Used-Service-Unit/CC-Total-Octets")
+	if err != nil || avps != nil {
+		t.Fatalf("Unexpected AVPs: %v, %v", avps, err)
+	}
+	for _, path := range []string{
+		"Multiple-Services-Credit-Control/No-Such-AVP",
+		"Multiple-Services-Credit-Control/Granted-Service-Unit/CC-Total-Octets/CC-Time",
+		"Multiple-Services-Credit-Control[0]",
+		"Multiple-Services-Credit-Control[1",
+	} {
+		if _, err = m.Lookup(path); err == nil {
+			t.Fatalf("Unexpected lookup of %s with no error", path)
+		}
+	}
+	v, err := m.Query("Multiple-Services-Credit-Control[2]/Granted-Service-Unit/CC-Total-Octets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v) != 1 || v[0] != datatype.Unsigned64(200) {
+		t.Fatalf("Unexpected values: %v", v)
+	}
+	if v, err = m.Query("Multiple-Services-Credit-Control[3]"); err != nil || v != nil {
+		t.Fatalf("Unexpected values: %v, %v", v, err)
+	}
+}
+
+func TestMessageFindAVPsVendor(t *testing.T) {
+	b := dict.NewBuilder()
+	app := b.App(1000, "auth", "Test")
+	app.AVP("Test-Int", 65000, datatype.Integer32Type).Must("M")
+	app.AVP("Vendor-Int", 65000, datatype.Integer32Type).Must("V").VendorID(99)
+	app.AVP("Test-Group", 65002, datatype.GroupedType).Must("M").Rule(&dict.Rule{AVP: "AVP"})
+	p, err := b.Parser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Vendor-Int has the code of Test-Int, but is a different AVP.
+	m := NewRequest(1, 1000, p)
+	m.NewAVP(65000, avp.Mbit, 0, datatype.Integer32(1))
+	m.NewAVP(65000, avp.Vbit, 99, datatype.Integer32(2))
+	m.NewAVP(65002, avp.Mbit, 0, &GroupedAVP{
+		AVP: []*AVP{
+			NewAVP(65000, avp.Vbit, 99, datatype.Integer32(3)),
+		},
+	})
+	for _, tc := range []struct {
+		path string
+		want datatype.Type
+	}{
+		{"Test-Int", datatype.Integer32(1)},
+		{"Vendor-Int", datatype.Integer32(2)},
+		{"Test-Group/Vendor-Int", datatype.Integer32(3)},
+	} {
+		avps, err := m.Lookup(tc.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(avps) != 1 || avps[0].Data != tc.want {
+			t.Fatalf("Unexpected AVPs for %s: %v", tc.path, avps)
+		}
+	}
+	if avps, err := m.Lookup("Test-Group/Test-Int"); err != nil || avps != nil {
+		t.Fatalf("Unexpected AVPs: %v, %v", avps, err)
+	}
+	avps, err := m.FindAVPs("Vendor-Int")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(avps) != 1 || avps[0].Data != datatype.Integer32(2) {
+		t.Fatalf("Unexpected AVPs: %v", avps)
+	}
+}
+
 func BenchmarkReadMessage(b *testing.B) {
 	reader := bytes.NewReader(testMessage)
 	for n := 0; n < b.N; n++ {
@@ -162,3 +693,92 @@ func BenchmarkWriteMessage(b *testing.B) {
 		m.WriteTo(ioutil.Discard)
 	}
 }
+
+func TestReadMessageRegisteredDataType(t *testing.T) {
+	// Decode the custom format as an upper case UTF8String.
+	id, err := datatype.Register("TestUpperString", func(b []byte) (datatype.Type, error) {
+		return datatype.UTF8String(strings.ToUpper(string(b))), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		delete(datatype.Available, "TestUpperString")
+		delete(datatype.Decoder, id)
+	}()
+	dp, err := dict.NewParser("./dict/xml/base.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dp.Load(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="0">
+		<avp name="Test-Upper" code="65000" must="M">
+			<data type="TestUpperString"/>
+		</avp>
+	</application>
+</diameter>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewRequest(DeviceWatchdog, 0, dp)
+	m.NewAVP(65000, avp.Mbit, 0, datatype.OctetString("hello"))
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err = ReadMessage(bytes.NewReader(b), dp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := m.AVP[0].Data; v != datatype.UTF8String("HELLO") {
+		t.Fatalf("Unexpected value. Want UTF8String{HELLO}, have %v", v)
+	}
+}
+
+func TestReadMessageOctetBytes(t *testing.T) {
+	decoder := datatype.Decoder[datatype.OctetStringType]
+	datatype.Decoder[datatype.OctetStringType] = datatype.DecodeOctetBytes
+	defer func() { datatype.Decoder[datatype.OctetStringType] = decoder }()
+	class := datatype.OctetBytes{0x00, 0x01, 0xfe, 0xff, 0x80}
+	m := NewRequest(AbortSession, 0, dict.Default)
+	m.NewAVP(avp.Class, avp.Mbit, 0, class)
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := HeaderLength + 8 + 8; len(b) != want {
+		t.Fatalf("Unexpected message length. Want %d, have %d", want, len(b))
+	}
+	m, err = ReadMessage(bytes.NewReader(b), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, ok := m.AVP[0].Data.(datatype.OctetBytes)
+	if !ok || !bytes.Equal(v, class) {
+		t.Fatalf("Unexpected value. Want %s, have %s", class, m.AVP[0].Data)
+	}
+}
+
+func TestMessageCloneEqual(t *testing.T) {
+	m, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := m.Clone()
+	if !m.Equal(c) {
+		t.Fatalf("Unexpected difference.\nWant: %s\nHave: %s", m, c)
+	}
+	c.Header.HopByHopID++
+	if m.Equal(c) {
+		t.Fatal("Unexpected equality after changing the clone header")
+	}
+	c = m.Clone()
+	c.AVP[0].Data = datatype.DiameterIdentity("other")
+	if m.Equal(c) {
+		t.Fatal("Unexpected equality after changing the clone AVP")
+	}
+	if v := m.AVP[0].Data; v != datatype.DiameterIdentity("test") {
+		t.Fatalf("Unexpected change of the original message: %s", v)
+	}
+}
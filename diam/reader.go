@@ -0,0 +1,170 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// MessageReader reads a message from a stream one AVP at a time, so
+// the ones that are not needed are not read nor decoded. For example,
+// an agent may route a request by its Destination-Realm, which
+// usually comes early in the message:
+//
+//	mr, err := diam.NewMessageReader(conn, dict.Default)
+//	if err != nil {
+//		return err
+//	}
+//	for {
+//		a, err := mr.Next()
+//		if err != nil {
+//			return err // io.EOF when there's no Destination-Realm
+//		}
+//		if a.Code == avp.DestinationRealm {
+//			route(a.Data.(datatype.DiameterIdentity))
+//			break
+//		}
+//	}
+//	m, err := mr.Message() // Reads the rest of the message.
+//
+// The message must be read in full, by Next or Message, before the
+// next message is read from the stream. Unlike ReadMessage, the
+// command does not have to be in the dictionary.
+type MessageReader struct {
+	Header  *Header
+	Options *DecodeOptions // Policies for decoding AVPs, nil for the defaults
+
+	r          io.Reader
+	dictionary *dict.Parser
+	offset     int // Offset of the next AVP in the message
+	avps       []*AVP
+}
+
+// NewMessageReader reads the header of the next message in r, and
+// returns a MessageReader for its AVPs. It uses the dictionary to
+// decode AVPs.
+func NewMessageReader(r io.Reader, dictionary *dict.Parser) (*MessageReader, error) {
+	b := make([]byte, HeaderLength)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, io.ErrUnexpectedEOF
+	}
+	hdr, err := DecodeHeader(b)
+	if err != nil {
+		return nil, err
+	}
+	if hdr.MessageLength < HeaderLength {
+		return nil, fmt.Errorf("Invalid message length: %d < %d",
+			hdr.MessageLength, HeaderLength)
+	}
+	return &MessageReader{
+		Header:     hdr,
+		r:          r,
+		dictionary: dictionary,
+		offset:     HeaderLength,
+	}, nil
+}
+
+// Next reads and decodes the next AVP of the message. It returns
+// io.EOF after the last AVP. AVPs that fail to decode are returned as
+// an *ErrDecodeAVP, after which the remaining AVPs may still be read.
+func (mr *MessageReader) Next() (*AVP, error) {
+	left := int(mr.Header.MessageLength) - mr.offset
+	if left <= 0 {
+		return nil, io.EOF
+	}
+	var hdr [8]byte
+	if left < len(hdr) {
+		return nil, mr.trailingData(hdr[:0], left)
+	}
+	if err := mr.read(hdr[:]); err != nil {
+		return nil, err
+	}
+	if isTrailingData(hdr[:]) {
+		return nil, mr.trailingData(hdr[:], left)
+	}
+	n := (int(uint24to32(hdr[5:8])) + 3) &^ 3
+	if n < len(hdr) || n > left {
+		// The length is invalid, so the rest of the message cannot
+		// be split into AVPs.
+		n = left
+	}
+	b := make([]byte, n)
+	copy(b, hdr[:])
+	if err := mr.read(b[len(hdr):]); err != nil {
+		return nil, err
+	}
+	offset := mr.offset
+	mr.offset += n
+	a := &AVP{}
+	if err := a.decode(b, mr.Header.ApplicationID, mr.dictionary, mr.Options); err != nil {
+		return nil, newErrDecodeAVP(offset, b, err)
+	}
+	mr.avps = append(mr.avps, a)
+	return a, nil
+}
+
+// read reads len(b) bytes of the message. The message is cut short
+// when the stream ends, which is reported as io.ErrUnexpectedEOF.
+func (mr *MessageReader) read(b []byte) error {
+	_, err := io.ReadFull(mr.r, b)
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// trailingData reads the left bytes at the end of the message, of
+// which b were read already, and handles them according to the
+// TrailingData policy of the options. It returns io.EOF when they are
+// ignored.
+func (mr *MessageReader) trailingData(b []byte, left int) error {
+	all := make([]byte, left)
+	copy(all, b)
+	if err := mr.read(all[len(b):]); err != nil {
+		return err
+	}
+	offset := mr.offset
+	mr.offset += left
+	if !isTrailingData(all) {
+		err := (&AVP{}).decode(all, mr.Header.ApplicationID, mr.dictionary, mr.Options)
+		return newErrDecodeAVP(offset, all, err)
+	}
+	if err := trailingData(&Message{Header: mr.Header}, offset, left, mr.Options); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// Message reads the remaining AVPs, and returns the message with all
+// of its AVPs, including the ones returned by Next. Like ReadMessage,
+// it returns the message along with an *ErrDecodeAVP for the first AVP
+// that failed to decode.
+func (mr *MessageReader) Message() (*Message, error) {
+	var derr error
+	for {
+		_, err := mr.Next()
+		if err == io.EOF {
+			break
+		}
+		if _, ok := err.(*ErrDecodeAVP); ok {
+			if derr == nil {
+				derr = err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	m := &Message{
+		Header:     mr.Header,
+		AVP:        mr.avps,
+		dictionary: mr.dictionary,
+	}
+	return m, derr
+}
@@ -0,0 +1,86 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestMessageReader(t *testing.T) {
+	want, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := bytes.NewReader(append(append([]byte(nil), testMessage...), testMessage...))
+	for n := 0; n < 2; n++ {
+		mr, err := NewMessageReader(r, dict.Default)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := mr.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Code != avp.OriginHost {
+			t.Fatalf("Unexpected AVP. Want Origin-Host, have %s", a)
+		}
+		if n == 0 {
+			// Read the first message in full.
+			for err == nil {
+				_, err = mr.Next()
+			}
+			if err != io.EOF {
+				t.Fatal(err)
+			}
+		}
+		m, err := mr.Message()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !m.Equal(want) {
+			t.Fatalf("Unexpected message.\nWant:\n%s\nHave:\n%s", want, m)
+		}
+	}
+	if _, err = NewMessageReader(r, dict.Default); err != io.ErrUnexpectedEOF {
+		t.Fatalf("Unexpected error. Want %v, have %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestMessageReaderDecodeError(t *testing.T) {
+	b := make([]byte, len(testMessage))
+	copy(b, testMessage)
+	b[54], b[55] = 0x27, 0x0f // Host-IP-Address is now AVP 9999.
+	mr, err := NewMessageReader(bytes.NewReader(b), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := mr.Message()
+	e, ok := err.(*ErrDecodeAVP)
+	if !ok {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e.Offset != 52 || e.ResultCode() != AVPUnsupported {
+		t.Fatalf("Unexpected error: %v", e)
+	}
+	want, _ := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if m == nil || len(m.AVP) != len(want.AVP)-1 || !m.AVP[2].Equal(want.AVP[3]) {
+		t.Fatalf("Unexpected message: %v", m)
+	}
+}
+
+func TestMessageReaderShortMessage(t *testing.T) {
+	mr, err := NewMessageReader(bytes.NewReader(testMessage[:60]), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = mr.Message(); err != io.ErrUnexpectedEOF {
+		t.Fatalf("Unexpected error. Want %v, have %v", io.ErrUnexpectedEOF, err)
+	}
+}
@@ -6,9 +6,30 @@ package diam
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"net"
 	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
+// Unmarshal stores the AVPs of the message m in the struct pointed to
+// by dst. See Message.Unmarshal.
+func Unmarshal(m *Message, dst interface{}) error {
+	return m.Unmarshal(dst)
+}
+
+// Marshal adds the fields of the struct src, or pointed to by src, to
+// the message m as AVPs. See Message.Marshal.
+func Marshal(m *Message, src interface{}) error {
+	return m.Marshal(src)
+}
+
 // Unmarshal stores the result of a diameter message in the struct
 // pointed to by dst.
 //
@@ -25,7 +46,7 @@ import (
 //		OriginHost  string `avp:"Origin-Host"`
 //	}
 //	var d CER
-//	err := diam.Unmarshal(&d)
+//	err := m.Unmarshal(&d)
 //
 // This decodes the Origin-Host AVP as three different types. The first, AVP,
 // makes a copy of the AVP in the message and stores in the struct. The
@@ -43,7 +64,7 @@ import (
 //		Vendors  []*AVP `avp:"Supported-Vendor-Id"`
 //	}
 //	var d CER
-//	err := diam.Unmarshal(&d)
+//	err := m.Unmarshal(&d)
 //
 // Slices have the same principles of other types. If they're of type
 // []*AVP it'll store references in the struct, while []AVP makes
@@ -144,7 +165,14 @@ func unmarshal(m *Message, f reflect.Value, avps []*AVP) {
 			unmarshal(m, f.Index(n), avps[n:])
 		}
 
-	case reflect.Interface, reflect.Ptr:
+	case reflect.Interface:
+		// Store the AVP data, e.g. datatype.Unsigned32.
+		dv := reflect.ValueOf(avps[0].Data)
+		if dv.Type().AssignableTo(fieldType) {
+			f.Set(dv)
+		}
+
+	case reflect.Ptr:
 		if f.IsNil() {
 			f.Set(reflect.New(fieldType.Elem()))
 		}
@@ -178,3 +206,307 @@ func unmarshal(m *Message, f reflect.Value, avps []*AVP) {
 		}
 	}
 }
+
+// Marshal adds the fields of the struct src, or pointed to by src, to
+// the message as AVPs, in the order of the fields. It is the inverse of
+// Unmarshal, and uses the same avp tags:
+//
+//	type CCR struct {
+//		SessionID   string   `avp:"Session-Id"`
+//		OriginHost  string   `avp:"Origin-Host"`
+//		RequestType int      `avp:"CC-Request-Type"`
+//		Username    *string  `avp:"User-Name"`
+//		Services    []MSCC   `avp:"Multiple-Services-Credit-Control"`
+//		ProxyInfo   []*AVP   `avp:"Proxy-Info"`
+//	}
+//	err := m.Marshal(&CCR{...})
+//
+// Fields of type AVP, *AVP or of a type of the datatype package are
+// added as they are. Other values are converted to the data type of
+// the AVP in the dictionary, such as int to Unsigned32, string to
+// UTF8String or net.IP to Address, and the AVP gets the flags and
+// vendor of the dictionary. Strings are also accepted as the item names
+// of Enumerated AVPs and as the text form of IP addresses. Structs are added as Grouped AVPs, slices
+// as one AVP per item, and nil pointers, interfaces and slices are
+// left out, which makes them suitable for optional AVPs.
+func (m *Message) Marshal(src interface{}) error {
+	avps, err := marshalStruct(m, reflect.ValueOf(src))
+	if err != nil {
+		return err
+	}
+	for _, a := range avps {
+		m.AddAVP(a)
+	}
+	return nil
+}
+
+// BuildAVPs returns the AVPs described by the template tmpl, which maps
+// AVP names of the application appid, or of the base protocol, to their
+// values. It allows AVPs to be built from configuration files or other
+// dynamic sources:
+//
+//	avps, err := diam.BuildAVPs(dict.Default, 4, map[string]interface{}{
+//		"Session-Id":      "client;1;2",
+//		"CC-Request-Type": 1,
+//		"Subscription-Id": map[string]interface{}{
+//			"Subscription-Id-Type": "END_USER_E164",
+//			"Subscription-Id-Data": "5511999999999",
+//		},
+//		"Multiple-Services-Credit-Control": []interface{}{
+//			map[string]interface{}{"Rating-Group": 1},
+//			map[string]interface{}{"Rating-Group": 2},
+//		},
+//	})
+//
+// Values are converted as by Marshal, with maps as Grouped AVPs and
+// slices as one AVP per item.
+//
+// Maps are not ordered: the AVPs are returned with Session-Id first, as
+// required by RFC 6733, followed by the others sorted by name.
+func BuildAVPs(dictionary *dict.Parser, appid uint32, tmpl map[string]interface{}) ([]*AVP, error) {
+	m := &Message{
+		Header:     &Header{ApplicationID: appid},
+		dictionary: dictionary,
+	}
+	return marshalMap(m, reflect.ValueOf(tmpl))
+}
+
+// marshalTypes are the Go types of the data types that Marshal
+// converts values to.
+var marshalTypes = map[datatype.TypeID]reflect.Type{
+	datatype.AddressType:          reflect.TypeOf(datatype.Address(nil)),
+	datatype.DiameterIdentityType: reflect.TypeOf(datatype.DiameterIdentity("")),
+	datatype.DiameterURIType:      reflect.TypeOf(datatype.DiameterURI("")),
+	datatype.EnumeratedType:       reflect.TypeOf(datatype.Enumerated(0)),
+	datatype.Float32Type:          reflect.TypeOf(datatype.Float32(0)),
+	datatype.Float64Type:          reflect.TypeOf(datatype.Float64(0)),
+	datatype.IPFilterRuleType:     reflect.TypeOf(datatype.IPFilterRule("")),
+	datatype.IPv4Type:             reflect.TypeOf(datatype.IPv4(nil)),
+	datatype.Integer32Type:        reflect.TypeOf(datatype.Integer32(0)),
+	datatype.Integer64Type:        reflect.TypeOf(datatype.Integer64(0)),
+	datatype.OctetStringType:      reflect.TypeOf(datatype.OctetString("")),
+	datatype.QoSFilterRuleType:    reflect.TypeOf(datatype.QoSFilterRule("")),
+	datatype.TBCDStringType:       reflect.TypeOf(datatype.TBCDString("")),
+	datatype.TimeType:             reflect.TypeOf(datatype.Time{}),
+	datatype.UTF8StringType:       reflect.TypeOf(datatype.UTF8String("")),
+	datatype.Unsigned32Type:       reflect.TypeOf(datatype.Unsigned32(0)),
+	datatype.Unsigned64Type:       reflect.TypeOf(datatype.Unsigned64(0)),
+}
+
+var (
+	avpType  = reflect.TypeOf(AVP{})
+	dataType = reflect.TypeOf((*datatype.Type)(nil)).Elem()
+)
+
+func marshalStruct(m *Message, v reflect.Value) ([]*AVP, error) {
+	base := reflect.Indirect(v)
+	if base.Kind() != reflect.Struct {
+		return nil, errors.New("src is not a struct or pointer to struct")
+	}
+	var avps []*AVP
+	for n := 0; n < base.NumField(); n++ {
+		avpname := base.Type().Field(n).Tag.Get("avp")
+		if len(avpname) == 0 || avpname == "-" {
+			continue
+		}
+		d, err := m.Dictionary().FindAVP(m.Header.ApplicationID, avpname)
+		if err != nil {
+			return nil, err
+		}
+		l, err := marshal(m, d, base.Field(n))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", avpname, err)
+		}
+		avps = append(avps, l...)
+	}
+	return avps, nil
+}
+
+// marshalMap returns the AVPs of the map v, keyed by AVP name.
+func marshalMap(m *Message, v reflect.Value) ([]*AVP, error) {
+	if v.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("cannot marshal %s: keys are not AVP names", v.Type())
+	}
+	names := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		names = append(names, k.String())
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[j] == "Session-Id" {
+			return false
+		}
+		return names[i] == "Session-Id" || names[i] < names[j]
+	})
+	var avps []*AVP
+	for _, name := range names {
+		d, err := m.Dictionary().FindAVP(m.Header.ApplicationID, name)
+		if err != nil {
+			return nil, err
+		}
+		l, err := marshal(m, d, v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key())))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+		avps = append(avps, l...)
+	}
+	return avps, nil
+}
+
+// marshal returns the AVPs of the field f, for the dictionary AVP d.
+func marshal(m *Message, d *dict.AVP, f reflect.Value) ([]*AVP, error) {
+	switch f.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if f.IsNil() {
+			return nil, nil
+		}
+		if a, ok := f.Interface().(*AVP); ok {
+			return []*AVP{a}, nil
+		}
+		if f.Kind() == reflect.Interface || !f.Type().Implements(dataType) ||
+			f.Elem().Type().Implements(dataType) {
+			return marshal(m, d, f.Elem())
+		}
+	case reflect.Struct:
+		if f.Type() == avpType {
+			a := f.Interface().(AVP)
+			return []*AVP{&a}, nil
+		}
+	case reflect.Slice:
+		if t, ok := marshalTypes[d.Data.Type]; ok && convertible(f.Type(), t) {
+			break
+		}
+		if r, ok := f.Interface().(datatype.Repeated); ok {
+			// One AVP per value, e.g. datatype.Unsigned32List.
+			var avps []*AVP
+			for _, v := range r.Values() {
+				avps = append(avps, NewAVP(d.Code, dictFlags(d), d.VendorID, v))
+			}
+			return avps, nil
+		}
+		if f.Type().Implements(dataType) {
+			break
+		}
+		var avps []*AVP
+		for n := 0; n < f.Len(); n++ {
+			l, err := marshal(m, d, f.Index(n))
+			if err != nil {
+				return nil, err
+			}
+			avps = append(avps, l...)
+		}
+		return avps, nil
+	}
+	data, err := marshalData(m, d, f)
+	if err != nil {
+		return nil, err
+	}
+	return []*AVP{NewAVP(d.Code, dictFlags(d), d.VendorID, data)}, nil
+}
+
+// dictFlags returns the flags of AVPs of the dictionary AVP d: the
+// M-bit when it must be set, and the V-bit for vendor specific AVPs.
+func dictFlags(d *dict.AVP) uint8 {
+	var flags uint8
+	if strings.Contains(d.Must, "M") {
+		flags |= avp.Mbit
+	}
+	if d.VendorID != 0 {
+		flags |= avp.Vbit
+	}
+	return flags
+}
+
+// marshalData converts f to the data type of the dictionary AVP d.
+func marshalData(m *Message, d *dict.AVP, f reflect.Value) (datatype.Type, error) {
+	if f.Type().Implements(dataType) {
+		return f.Interface().(datatype.Type), nil
+	}
+	if d.Data.Type == datatype.GroupedType && f.Kind() == reflect.Struct {
+		avps, err := marshalStruct(m, f)
+		if err != nil {
+			return nil, err
+		}
+		return &GroupedAVP{AVP: avps}, nil
+	}
+	if d.Data.Type == datatype.GroupedType && f.Kind() == reflect.Map {
+		avps, err := marshalMap(m, f)
+		if err != nil {
+			return nil, err
+		}
+		return &GroupedAVP{AVP: avps}, nil
+	}
+	if f.Kind() == reflect.String {
+		switch d.Data.Type {
+		case datatype.EnumeratedType:
+			for _, item := range d.Data.Enum {
+				if item.Name == f.String() {
+					return datatype.Enumerated(item.Code), nil
+				}
+			}
+			return nil, fmt.Errorf("unknown item %q", f.String())
+		case datatype.AddressType:
+			if ip := net.ParseIP(f.String()); ip != nil {
+				return datatype.Address(ip), nil
+			}
+			return nil, fmt.Errorf("invalid address %q", f.String())
+		case datatype.IPv4Type:
+			if ip := net.ParseIP(f.String()).To4(); ip != nil {
+				return datatype.IPv4(ip), nil
+			}
+			return nil, fmt.Errorf("invalid IPv4 address %q", f.String())
+		}
+	}
+	if t, ok := marshalTypes[d.Data.Type]; ok && convertible(f.Type(), t) {
+		if !inRange(f, d.Data.Type) {
+			return nil, fmt.Errorf("%v is out of range for %s", f.Interface(), d.Data.TypeName)
+		}
+		return f.Convert(t).Interface().(datatype.Type), nil
+	}
+	return nil, fmt.Errorf("cannot marshal %s as %s", f.Type(), d.Data.TypeName)
+}
+
+// intRanges are the ranges of values of the integer data types.
+var intRanges = map[datatype.TypeID]struct {
+	min int64
+	max uint64
+}{
+	datatype.EnumeratedType: {math.MinInt32, math.MaxInt32},
+	datatype.Integer32Type:  {math.MinInt32, math.MaxInt32},
+	datatype.Integer64Type:  {math.MinInt64, math.MaxInt64},
+	datatype.Unsigned32Type: {0, math.MaxUint32},
+	datatype.Unsigned64Type: {0, math.MaxUint64},
+}
+
+// inRange reports whether the number f fits in the integer data type
+// id, which reflect does not check when converting. Other values and
+// data types are always in range.
+func inRange(f reflect.Value, id datatype.TypeID) bool {
+	r, ok := intRanges[id]
+	if !ok {
+		return true
+	}
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v := f.Int()
+		return v >= r.min && (v < 0 || uint64(v) <= r.max)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return f.Uint() <= r.max
+	case reflect.Float32, reflect.Float64:
+		v := f.Float()
+		return v >= float64(r.min) && v <= float64(r.max)
+	}
+	return true
+}
+
+// convertible reports whether values of type from can be converted to
+// type to. Unlike reflect, it does not convert integers to strings.
+func convertible(from, to reflect.Type) bool {
+	if to.Kind() == reflect.String {
+		switch from.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return false
+		}
+	}
+	return from.ConvertibleTo(to)
+}
@@ -9,8 +9,9 @@ import (
 	"net"
 	"testing"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 func TestUnmarshalAVP(t *testing.T) {
@@ -88,8 +89,9 @@ func TestUnmarshalInt(t *testing.T) {
 func TestUnmarshalSlice(t *testing.T) {
 	m, _ := ReadMessage(bytes.NewReader(testMessage), dict.Default)
 	type Data struct {
-		Vendors1 []*AVP `avp:"Supported-Vendor-Id"`
-		Vendors2 []int  `avp:"Supported-Vendor-Id"`
+		Vendors1 []*AVP                  `avp:"Supported-Vendor-Id"`
+		Vendors2 []int                   `avp:"Supported-Vendor-Id"`
+		Vendors3 datatype.Unsigned32List `avp:"Supported-Vendor-Id"`
 	}
 	var d Data
 	if err := m.Unmarshal(&d); err != nil {
@@ -113,6 +115,9 @@ func TestUnmarshalSlice(t *testing.T) {
 	if d.Vendors2[1] != 13 {
 		t.Fatalf("Unexpected value. Want 13, have %d", d.Vendors2[1])
 	}
+	if len(d.Vendors3) != 2 || d.Vendors3[1] != 13 {
+		t.Fatalf("Unexpected value. Want [10415 13], have %v", d.Vendors3)
+	}
 }
 
 func TestUnmarshalGrouped(t *testing.T) {
@@ -309,3 +314,168 @@ func BenchmarkUnmarshal(b *testing.B) {
 		msg.Unmarshal(&cer)
 	}
 }
+
+func TestMarshalCER(t *testing.T) {
+	type VSA struct {
+		AuthAppID int `avp:"Auth-Application-Id"`
+		VendorID  int `avp:"Vendor-Id"`
+	}
+	type CER struct {
+		OriginHost  string                    `avp:"Origin-Host"`
+		OriginRealm datatype.DiameterIdentity `avp:"Origin-Realm"`
+		HostIP      net.IP                    `avp:"Host-IP-Address"`
+		VendorID    int                       `avp:"Vendor-Id"`
+		ProductName *string                   `avp:"Product-Name"`
+		StateID     uint32                    `avp:"Origin-State-Id"`
+		Vendors     []int                     `avp:"Supported-Vendor-Id"`
+		AuthAppID   *AVP                      `avp:"Auth-Application-Id"`
+		InbandSecID int                       `avp:"Inband-Security-Id"`
+		AcctAppID   []int                     `avp:"Acct-Application-Id"`
+		VSA         *VSA                      `avp:"Vendor-Specific-Application-Id"`
+		Firmware    interface{}               `avp:"Firmware-Revision"`
+		Ignored     int
+	}
+	want, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	product := "go-diameter"
+	m := NewMessage(CapabilitiesExchange, RequestFlag, 0,
+		want.Header.HopByHopID, want.Header.EndToEndID, dict.Default)
+	err = Marshal(m, &CER{
+		OriginHost:  "test",
+		OriginRealm: "localhost",
+		HostIP:      net.ParseIP("10.1.0.1"),
+		VendorID:    13,
+		ProductName: &product,
+		StateID:     1397760650,
+		Vendors:     []int{10415, 13},
+		AuthAppID:   want.AVP[8],
+		VSA:         &VSA{AuthAppID: 4, VendorID: 10415},
+		Firmware:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, testMessage) {
+		t.Fatalf("Unexpected message.\nWant:\n%s\nHave:\n%s", want, m)
+	}
+	var d CER
+	if err = Unmarshal(m, &d); err != nil {
+		t.Fatal(err)
+	}
+	if *d.ProductName != product || d.VSA.VendorID != 10415 || d.AcctAppID != nil ||
+		d.Firmware != datatype.Unsigned32(1) {
+		t.Fatalf("Unexpected value: %+v", d)
+	}
+}
+
+func TestMarshalError(t *testing.T) {
+	m := NewRequest(CapabilitiesExchange, 0, dict.Default)
+	err := m.Marshal(struct {
+		OriginHost int `avp:"Origin-Host"`
+	}{1})
+	if err == nil {
+		t.Fatal("Integer marshaled as DiameterIdentity")
+	}
+	if err = m.Marshal(1); err == nil {
+		t.Fatal("Marshaled an integer as a struct")
+	}
+	err = m.Marshal(struct {
+		VendorID int `avp:"Vendor-Id"`
+	}{-1})
+	if err == nil {
+		t.Fatal("Negative integer marshaled as Unsigned32")
+	}
+	err = m.Marshal(struct {
+		ResultCode uint64 `avp:"Result-Code"`
+	}{1 << 32})
+	if err == nil {
+		t.Fatal("Integer out of range marshaled as Unsigned32")
+	}
+}
+
+func TestMarshalRepeated(t *testing.T) {
+	m := NewRequest(CapabilitiesExchange, 0, dict.Default)
+	err := m.Marshal(struct {
+		Vendors datatype.Unsigned32List `avp:"Supported-Vendor-Id"`
+	}{datatype.Unsigned32List{10415, 13}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.AVP) != 2 {
+		t.Fatalf("Unexpected number of AVPs. Want 2, have %d", len(m.AVP))
+	}
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err = ReadMessage(bytes.NewReader(b), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.AVP) != 2 || m.AVP[1].Data != datatype.Unsigned32(13) {
+		t.Fatalf("Unexpected message: %s", m)
+	}
+}
+
+func TestBuildAVPs(t *testing.T) {
+	avps, err := BuildAVPs(dict.Default, 4, map[string]interface{}{
+		"Origin-Host":     "test",
+		"Session-Id":      "client;1;2",
+		"CC-Request-Type": 1,
+		"Host-IP-Address": "10.1.0.1",
+		"Subscription-Id": map[string]interface{}{
+			"Subscription-Id-Type": "END_USER_E164",
+			"Subscription-Id-Data": "5511999999999",
+		},
+		"Multiple-Services-Credit-Control": []interface{}{
+			map[string]interface{}{"Rating-Group": 1},
+			map[string]interface{}{"Rating-Group": 2.0},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint32{
+		avp.SessionID,
+		avp.CCRequestType,
+		avp.HostIPAddress,
+		avp.MultipleServicesCreditControl,
+		avp.MultipleServicesCreditControl,
+		avp.OriginHost,
+		avp.SubscriptionID,
+	}
+	if len(avps) != len(want) {
+		t.Fatalf("Unexpected number of AVPs. Want %d, have %d", len(want), len(avps))
+	}
+	for n, code := range want {
+		if avps[n].Code != code {
+			t.Fatalf("Unexpected AVP #%d. Want %d, have %d", n, code, avps[n].Code)
+		}
+	}
+	if v := avps[2].Data.(datatype.Address); net.IP(v).String() != "10.1.0.1" {
+		t.Fatalf("Unexpected Host-IP-Address. Want 10.1.0.1, have %s", net.IP(v))
+	}
+	sub := avps[6].Data.(*GroupedAVP).AVP
+	if len(sub) != 2 || sub[1].Code != avp.SubscriptionIDType || sub[1].Data != datatype.Enumerated(0) {
+		t.Fatalf("Unexpected Subscription-Id: %s", avps[6])
+	}
+	rg := avps[4].Data.(*GroupedAVP).AVP[0]
+	if rg.Data != datatype.Unsigned32(2) || rg.Flags != avp.Mbit {
+		t.Fatalf("Unexpected Rating-Group: %s", rg)
+	}
+	for _, tmpl := range []map[string]interface{}{
+		{"No-Such-AVP": 1},
+		{"Subscription-Id": map[string]interface{}{"Subscription-Id-Type": "NONE"}},
+		{"CC-Request-Type": map[string]interface{}{}},
+	} {
+		if _, err = BuildAVPs(dict.Default, 4, tmpl); err == nil {
+			t.Fatalf("Built invalid template %v", tmpl)
+		}
+	}
+}
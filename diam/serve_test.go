@@ -8,13 +8,16 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/diamtest"
+	"golang.org/x/net/context"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
 )
 
 func TestCapabilitiesExchange(t *testing.T) {
@@ -203,3 +206,155 @@ func handleCEA(errc chan error, wait chan struct{}) diam.HandlerFunc {
 		<-c.(diam.CloseNotifier).CloseNotify()
 	}
 }
+
+// TestConcurrentWrites sends requests from many goroutines over a single
+// Conn and checks that every request gets a unique Hop-by-Hop Identifier
+// and that every answer is matched to its request.
+func TestConcurrentWrites(t *testing.T) {
+	const senders = 10000
+	smux := diam.NewServeMux()
+	smux.HandleFunc("DWR", func(c diam.Conn, m *diam.Message) {
+		a := m.Answer(diam.Success)
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("srv"))
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("localhost"))
+		a.WriteTo(c)
+	})
+	srv := diamtest.NewServer(smux, nil)
+	defer srv.Close()
+
+	var mu sync.Mutex
+	pending := make(map[uint32]chan *diam.Message, senders)
+	cmux := diam.NewServeMux()
+	cmux.HandleFunc("DWA", func(c diam.Conn, m *diam.Message) {
+		mu.Lock()
+		ac, ok := pending[m.Header.HopByHopID]
+		delete(pending, m.Header.HopByHopID)
+		mu.Unlock()
+		if ok {
+			ac <- m
+		}
+	})
+	cli, err := diam.Dial(srv.Address, cmux, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	errc := make(chan error, senders)
+	var wg sync.WaitGroup
+	wg.Add(senders)
+	for n := 0; n < senders; n++ {
+		go func() {
+			defer wg.Done()
+			m := diam.NewRequest(diam.DeviceWatchdog, 0, nil)
+			m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("cli"))
+			m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("localhost"))
+			hbh := m.Header.HopByHopID
+			ac := make(chan *diam.Message, 1)
+			mu.Lock()
+			_, dup := pending[hbh]
+			pending[hbh] = ac
+			mu.Unlock()
+			if dup {
+				errc <- fmt.Errorf("duplicate Hop-by-Hop Identifier 0x%x", hbh)
+				return
+			}
+			if _, err := m.WriteTo(cli); err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case a := <-ac:
+				if a.Header.HopByHopID != hbh || a.Header.EndToEndID != m.Header.EndToEndID {
+					errc <- fmt.Errorf("answer mismatch: want 0x%x, have 0x%x",
+						hbh, a.Header.HopByHopID)
+				}
+			case <-time.After(10 * time.Second):
+				errc <- fmt.Errorf("no answer for 0x%x", hbh)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errc)
+	for err := range errc {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteAborted(t *testing.T) {
+	errc := make(chan error, 2)
+	smux := diam.NewServeMux()
+	smux.HandleFunc("DWR", func(c diam.Conn, m *diam.Message) {
+		// Answer after the context of the request is done.
+		ctx, cancel := context.WithCancel(c.Context())
+		cancel()
+		_, err := diam.WriteContext(ctx, c, m.Answer(diam.Success))
+		errc <- err
+		// Answer after the connection is closed.
+		c.Close()
+		_, err = m.Answer(diam.Success).WriteTo(c)
+		errc <- err
+	})
+	srv := diamtest.NewServer(smux, nil)
+	defer srv.Close()
+
+	cli, err := diam.Dial(srv.Address, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	m := diam.NewRequest(diam.DeviceWatchdog, 0, nil)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("cli"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("localhost"))
+	if _, err := m.WriteTo(cli); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []error{context.Canceled, diam.ErrConnClosed} {
+		select {
+		case err := <-errc:
+			e, ok := err.(*diam.ErrWriteAborted)
+			if !ok {
+				t.Fatalf("Unexpected error. Want ErrWriteAborted, have %v", err)
+			}
+			if e.Err != want {
+				t.Fatalf("Unexpected reason. Want %v, have %v", want, e.Err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Timed out: no DWR received")
+		}
+	}
+	if n := srv.Config.AbortedWrites(); n != 2 {
+		t.Fatalf("Unexpected aborted writes. Want 2, have %d", n)
+	}
+}
+
+func TestServeMux_HandleRealm(t *testing.T) {
+	var have string
+	mux := diam.NewServeMux()
+	mux.HandleFunc("CCR", func(c diam.Conn, m *diam.Message) { have = "CCR" })
+	mux.HandleFunc("ALL", func(c diam.Conn, m *diam.Message) { have = "ALL" })
+	mux.HandleRealmFunc("A.example.com", func(c diam.Conn, m *diam.Message) { have = "a" })
+	mux.HandleRealmFunc("b.example.com", func(c diam.Conn, m *diam.Message) { have = "b" })
+	for _, tc := range []struct {
+		cmd   uint32
+		realm string
+		want  string
+	}{
+		{diam.CreditControl, "a.example.com", "CCR"},
+		{diam.ReAuth, "a.example.com", "a"},
+		{diam.ReAuth, "B.example.com", "b"},
+		{diam.ReAuth, "c.example.com", "ALL"},
+		{diam.ReAuth, "", "ALL"},
+	} {
+		m := diam.NewRequest(tc.cmd, 4, nil)
+		if tc.realm != "" {
+			m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(tc.realm))
+		}
+		have = ""
+		mux.ServeDIAM(nil, m)
+		if have != tc.want {
+			t.Fatalf("Unexpected handler for command %d to %q. Want %s, have %s",
+				tc.cmd, tc.realm, tc.want, have)
+		}
+	}
+}
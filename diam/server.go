@@ -15,12 +15,16 @@ import (
 	"log"
 	"net"
 	"runtime"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
 
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 // The Handler interface allow arbitrary objects to be
@@ -34,6 +38,12 @@ type Handler interface {
 }
 
 // Conn interface is used by a handler to send diameter messages.
+//
+// A Conn is safe for concurrent use by multiple goroutines. Each call
+// to Write is serialized and must contain whole messages, so messages
+// written concurrently are never interleaved on the wire. Requests
+// created by NewRequest get unique Hop-by-Hop Identifiers that can be
+// used to match their answers, even when created concurrently.
 type Conn interface {
 	Write(b []byte) (int, error)    // Writes a msg to the connection
 	Close()                         // Close the connection
@@ -45,6 +55,26 @@ type Conn interface {
 	SetContext(ctx context.Context) // Stores a new context
 }
 
+// ErrConnClosed is the reason of an ErrWriteAborted for writes to
+// connections that were closed, or whose peer has gone away.
+var ErrConnClosed = errors.New("connection closed")
+
+// ErrWriteAborted is returned by Conn.Write when a message is written
+// to a dead connection, with Err set to ErrConnClosed, and by
+// WriteContext when its context is done, with Err set to the error of
+// the context, for example because the deadline of the request passed
+// before the answer was written. The message is not written.
+// See Server.AbortedWrites.
+type ErrWriteAborted struct {
+	RemoteAddr net.Addr
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *ErrWriteAborted) Error() string {
+	return fmt.Sprintf("write to %s aborted: %s", e.RemoteAddr, e.Err)
+}
+
 // The CloseNotifier interface is implemented by Conns which
 // allow detecting when the underlying connection has gone away.
 //
@@ -55,6 +85,18 @@ type CloseNotifier interface {
 	CloseNotify() <-chan struct{}
 }
 
+// The DictionarySetter interface is implemented by Conns which
+// allow replacing the dictionary used to encode and decode messages
+// of that particular connection.
+//
+// This mechanism can be used to associate a peer with a dictionary
+// that contains extensions only supported by that peer.
+type DictionarySetter interface {
+	// SetDictionary replaces the dictionary of the connection.
+	// Messages read after the call are decoded using dp.
+	SetDictionary(dp *dict.Parser)
+}
+
 // A liveSwitchReader is a switchReader that's safe for concurrent
 // reads and switches, if its mutex is held.
 type liveSwitchReader struct {
@@ -77,10 +119,13 @@ type conn struct {
 	buf      *bufio.ReadWriter    // buffered(sr, rwc)
 	tlsState *tls.ConnectionState // or nil when not using TLS
 	writer   *response            // the diam.Conn exposed to handlers
+	shaking  bool                 // in the handshake, used by the serve goroutine only
 
 	mu           sync.Mutex // guards the following
 	closeNotifyc chan struct{}
 	clientGone   bool
+	closed       bool         // rwc has been closed
+	dict         *dict.Parser // per-connection dictionary, or nil
 }
 
 func (c *conn) closeNotify() <-chan struct{} {
@@ -105,6 +150,23 @@ func (c *conn) closeNotify() <-chan struct{} {
 	return c.closeNotifyc
 }
 
+// close closes the connection and marks it as closed so that further
+// writes are aborted.
+func (c *conn) close() {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+	c.rwc.Close()
+}
+
+// isClosed reports whether the connection has been closed, or its peer
+// has gone away.
+func (c *conn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed || c.clientGone
+}
+
 func (c *conn) notifyClientGone() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -128,14 +190,12 @@ func (srv *Server) newConn(rwc net.Conn) (c *conn, err error) {
 
 // Read next message from connection.
 func (c *conn) readMessage() (*Message, error) {
-	if c.server.ReadTimeout > 0 {
+	if c.shaking && c.server.HandshakeTimeout > 0 {
+		c.rwc.SetReadDeadline(time.Now().Add(c.server.HandshakeTimeout))
+	} else if c.server.ReadTimeout > 0 {
 		c.rwc.SetReadDeadline(time.Now().Add(c.server.ReadTimeout))
 	}
-	m, err := ReadMessage(c.buf.Reader, c.dictionary())
-	if err != nil {
-		return nil, err
-	}
-	return m, nil
+	return ReadMessageWithOptions(c.buf.Reader, c.dictionary(), c.server.DecodeOptions)
 }
 
 // Serve a new connection.
@@ -147,41 +207,83 @@ func (c *conn) serve() {
 			log.Printf("diam: panic serving %v: %v\n%s",
 				c.rwc.RemoteAddr().String(), err, buf)
 		}
-		c.rwc.Close()
+		c.endHandshake()
+		c.close()
 	}()
 	if tlsConn, ok := c.rwc.(*tls.Conn); ok {
+		if c.shaking && c.server.HandshakeTimeout > 0 {
+			c.rwc.SetDeadline(time.Now().Add(c.server.HandshakeTimeout))
+		}
 		if err := tlsConn.Handshake(); err != nil {
 			return
 		}
+		if c.shaking && c.server.HandshakeTimeout > 0 {
+			c.rwc.SetWriteDeadline(time.Time{})
+		}
 		c.tlsState = &tls.ConnectionState{}
 		*c.tlsState = tlsConn.ConnectionState()
 	}
 	for {
 		m, err := c.readMessage()
+		if _, ok := err.(*ErrDecodeAVP); ok && m != nil {
+			// The message was read in full, so the connection is
+			// still usable, and the handler may answer the request.
+			log.Printf("diam: %s (command %d from %s)",
+				err, m.Header.CommandCode, c.rwc.RemoteAddr())
+			c.server.Blacklist.Fail(c.rwc.RemoteAddr(), err)
+			c.reportError(m, err)
+			continue
+		}
 		if err != nil {
-			c.rwc.Close()
+			closed := c.isClosed()
+			c.close()
 			// Report errors to the channel, except EOF.
 			if err != io.EOF && err != io.ErrUnexpectedEOF {
-				h := c.server.Handler
-				if h == nil {
-					h = DefaultServeMux
-				}
-				if er, ok := h.(ErrorReporter); ok {
-					er.Error(&ErrorReport{c.writer, m, err})
+				// Connections closed by us, for example after
+				// a failed CER, and timeouts are not failures
+				// of the peer.
+				if !closed && !isTimeout(err) {
+					c.server.Blacklist.Fail(c.rwc.RemoteAddr(), err)
 				}
+				c.reportError(m, err)
 			}
 			break
 		}
+		c.endHandshake()
 		// Handle messages in this goroutine.
 		serverHandler{c.server}.ServeDIAM(c.writer, m)
 	}
 }
 
-// dictionary returns the dictionary parser associated to the Server instance
-// or dict.Default.
+// isTimeout reports whether err is a network timeout.
+func isTimeout(err error) bool {
+	ne, ok := err.(net.Error)
+	return ok && ne.Timeout()
+}
+
+// reportError reports an error reading the message m to the server's
+// handler, if it is an ErrorReporter.
+func (c *conn) reportError(m *Message, err error) {
+	h := c.server.Handler
+	if h == nil {
+		h = DefaultServeMux
+	}
+	if er, ok := h.(ErrorReporter); ok {
+		er.Error(&ErrorReport{c.writer, m, err})
+	}
+}
+
+// dictionary returns the dictionary parser associated to the connection,
+// the Server instance, or the default dictionary.
 func (c *conn) dictionary() *dict.Parser {
+	c.mu.Lock()
+	dp := c.dict
+	c.mu.Unlock()
+	if dp != nil {
+		return dp
+	}
 	if c.server.Dict == nil {
-		return dict.Default
+		return dict.DefaultParser()
 	}
 	return c.server.Dict
 }
@@ -196,7 +298,13 @@ type response struct {
 }
 
 // Write writes the message m to the connection.
+// It returns ErrWriteAborted without writing if the connection is
+// dead.
 func (w *response) Write(b []byte) (int, error) {
+	if w.conn.isClosed() {
+		atomic.AddUint64(&w.conn.server.aborted, 1)
+		return 0, &ErrWriteAborted{RemoteAddr: w.RemoteAddr(), Err: ErrConnClosed}
+	}
 	w.mu.Lock()
 	defer w.mu.Unlock()
 	if w.conn.server.WriteTimeout > 0 {
@@ -212,9 +320,29 @@ func (w *response) Write(b []byte) (int, error) {
 	return n, nil
 }
 
+// WriteContext writes the message m to the connection c, unless ctx is
+// done, in which case it returns ErrWriteAborted without writing. The
+// context is usually the one of the request being answered, carrying
+// its deadline:
+//
+//	ctx, cancel := context.WithTimeout(c.Context(), time.Second)
+//	defer cancel()
+//	a := m.Answer(diam.Success)
+//	// ... look up the answer, which may take a while.
+//	_, err := diam.WriteContext(ctx, c, a)
+func WriteContext(ctx context.Context, c Conn, m *Message) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		if w, ok := c.(*response); ok {
+			atomic.AddUint64(&w.conn.server.aborted, 1)
+		}
+		return 0, &ErrWriteAborted{RemoteAddr: c.RemoteAddr(), Err: err}
+	}
+	return m.WriteTo(c)
+}
+
 // Close closes the connection.
 func (w *response) Close() {
-	w.conn.rwc.Close()
+	w.conn.close()
 }
 
 // LocalAddr returns the local address of the connection.
@@ -238,6 +366,13 @@ func (w *response) Dictionary() *dict.Parser {
 	return w.conn.dictionary()
 }
 
+// SetDictionary implements the DictionarySetter interface.
+func (w *response) SetDictionary(dp *dict.Parser) {
+	w.conn.mu.Lock()
+	w.conn.dict = dp
+	w.conn.mu.Unlock()
+}
+
 // CloseNotify implements the CloseNotifier interface.
 func (w *response) CloseNotify() <-chan struct{} {
 	return w.conn.closeNotify()
@@ -271,6 +406,28 @@ func (f HandlerFunc) ServeDIAM(c Conn, m *Message) {
 	f(c, m)
 }
 
+// ResultError is an error to be answered with a Result-Code other
+// than DIAMETER_SUCCESS, for example MissingAVP (5005). See
+// Message.ErrorAnswer and sm.HandlerE.
+type ResultError struct {
+	Code      uint32 // Result-Code of the answer
+	Message   string // Error-Message of the answer, optional
+	FailedAVP []*AVP // AVPs that caused the error, optional
+
+	// ReportingHost is the host that found the error, when it is not
+	// the one answering, such as a server behind a relay. It is sent
+	// in the Error-Reporting-Host AVP.
+	ReportingHost datatype.DiameterIdentity
+}
+
+// Error implements the error interface.
+func (e *ResultError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("diameter result code %d", e.Code)
+	}
+	return fmt.Sprintf("diameter result code %d: %s", e.Code, e.Message)
+}
+
 // The ErrorReporter interface is implemented by Handlers that
 // allow reading errors from the underlying connection, like
 // parsing diameter messages or connection errors.
@@ -302,10 +459,15 @@ func (er *ErrorReport) String() string {
 // ServeMux is a diameter message multiplexer. It matches the
 // command from the incoming message against a list of
 // registered commands and calls the handler.
+//
+// Requests with no handler for their command are dispatched to the
+// handler registered by HandleRealm for their Destination-Realm, if
+// any, and then to the "ALL" handler.
 type ServeMux struct {
-	e  chan *ErrorReport
-	mu sync.RWMutex // Guards m.
-	m  map[string]muxEntry
+	e     chan *ErrorReport
+	mu    sync.RWMutex // Guards m and realm.
+	m     map[string]muxEntry
+	realm map[string]Handler // by lower case Destination-Realm
 }
 
 type muxEntry struct {
@@ -316,8 +478,9 @@ type muxEntry struct {
 // NewServeMux allocates and returns a new ServeMux.
 func NewServeMux() *ServeMux {
 	return &ServeMux{
-		e: make(chan *ErrorReport, 1),
-		m: make(map[string]muxEntry),
+		e:     make(chan *ErrorReport, 1),
+		m:     make(map[string]muxEntry),
+		realm: make(map[string]Handler),
 	}
 }
 
@@ -338,8 +501,10 @@ func (mux *ServeMux) ErrorReports() <-chan *ErrorReport {
 }
 
 // ServeDIAM dispatches the request to the handler that match the code
-// in the incoming message. If the special "ALL" handler is registered
-// it is used as a catch-all. Otherwise an ErrorReport is sent out.
+// in the incoming message. If there is none, the handler of the
+// Destination-Realm of the request is used, and if the special "ALL"
+// handler is registered it is used as a catch-all. Otherwise an
+// ErrorReport is sent out.
 func (mux *ServeMux) ServeDIAM(c Conn, m *Message) {
 	mux.mu.RLock()
 	defer mux.mu.RUnlock()
@@ -348,8 +513,8 @@ func (mux *ServeMux) ServeDIAM(c Conn, m *Message) {
 		m.Header.CommandCode,
 	)
 	if err != nil {
-		// Try the catch-all.
-		mux.serve("ALL", c, m)
+		// Try the realm and catch-all handlers.
+		mux.serve("", c, m)
 		return
 	}
 	var cmd string
@@ -367,6 +532,10 @@ func (mux *ServeMux) serve(cmd string, c Conn, m *Message) {
 		entry.h.ServeDIAM(c, m)
 		return
 	}
+	if h := mux.realmHandler(m); h != nil {
+		h.ServeDIAM(c, m)
+		return
+	}
 	// Try catch-all.
 	entry, ok = mux.m["ALL"]
 	if ok {
@@ -397,6 +566,43 @@ func (mux *ServeMux) HandleFunc(cmd string, handler func(Conn, *Message)) {
 	mux.Handle(cmd, HandlerFunc(handler))
 }
 
+// HandleRealm registers the default handler for requests to the given
+// Destination-Realm, used for commands that have no handler of their
+// own. It allows a single process to serve multiple realms, each with
+// its own fallback. Realms are matched regardless of case.
+func (mux *ServeMux) HandleRealm(realm string, handler Handler) {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	if handler == nil {
+		panic("DIAM: nil handler")
+	}
+	mux.realm[strings.ToLower(realm)] = handler
+}
+
+// HandleRealmFunc registers the default handler function for requests
+// to the given Destination-Realm. See HandleRealm.
+func (mux *ServeMux) HandleRealmFunc(realm string, handler func(Conn, *Message)) {
+	mux.HandleRealm(realm, HandlerFunc(handler))
+}
+
+// realmHandler returns the handler of the Destination-Realm of the
+// request m, or nil.
+func (mux *ServeMux) realmHandler(m *Message) Handler {
+	if len(mux.realm) == 0 || m.Header.CommandFlags&RequestFlag == 0 {
+		return nil
+	}
+	for _, a := range m.AVP {
+		if a.Code != avp.DestinationRealm {
+			continue
+		}
+		if realm, ok := a.Data.(datatype.DiameterIdentity); ok {
+			return mux.realm[strings.ToLower(string(realm))]
+		}
+		return nil
+	}
+	return nil
+}
+
 // Handle registers the handler object for the given command
 // in the DefaultServeMux.
 func Handle(cmd string, handler Handler) {
@@ -425,12 +631,46 @@ func Serve(l net.Listener, handler Handler) error {
 
 // A Server defines parameters for running a diameter server.
 type Server struct {
+	rejected  uint64 // # of connections rejected by AllowNet or DenyNet, accessed atomically
+	aborted   uint64 // # of writes aborted on dead connections, accessed atomically
+	throttled uint64 // # of connections dropped by MaxHandshakes, accessed atomically
+	pending   int64  // # of connections in the handshake, accessed atomically
+
 	Addr         string        // TCP address to listen on, ":3868" if empty
 	Handler      Handler       // handler to invoke, DefaultServeMux if nil
 	Dict         *dict.Parser  // diameter dictionaries for this server
 	ReadTimeout  time.Duration // maximum duration before timing out read of the request
 	WriteTimeout time.Duration // maximum duration before timing out write of the response
 	TLSConfig    *tls.Config   // optional TLS config, used by ListenAndServeTLS
+
+	// AllowNet and DenyNet filter incoming connections by source IP.
+	// When AllowNet is set, only connections from its networks are
+	// accepted. Connections from networks in DenyNet are always
+	// rejected. Rejected connections are closed right after being
+	// accepted, before any TLS handshake or diameter message is read.
+	// See ParseNetworks and Server.Rejected.
+	AllowNet []*net.IPNet
+	DenyNet  []*net.IPNet
+
+	// MaxHandshakes limits the number of connections that are still in
+	// the handshake: accepted, but not done with the TLS handshake and
+	// the first diameter message, typically the CER. Connections
+	// accepted over the limit are closed right away. Zero means no
+	// limit. See Server.Throttled.
+	//
+	// HandshakeTimeout is the maximum duration of the handshake. It
+	// defaults to ReadTimeout.
+	MaxHandshakes    int
+	HandshakeTimeout time.Duration
+
+	// Blacklist, when set, records peers that send messages that
+	// cannot be read, and connections from the peers it blocks are
+	// rejected like the ones from DenyNet. See Blacklist.
+	Blacklist *Blacklist
+
+	// DecodeOptions, when set, are the policies for decoding messages
+	// from peers that do not follow the RFCs to the letter.
+	DecodeOptions *DecodeOptions
 }
 
 // serverHandler delegates to either the server's Handler or DefaultServeMux.
@@ -446,6 +686,13 @@ func (sh serverHandler) ServeDIAM(w Conn, m *Message) {
 	handler.ServeDIAM(w, m)
 }
 
+// AbortedWrites returns the number of messages that were not written
+// because their connection was dead, or because the context given to
+// WriteContext was done. See ErrWriteAborted.
+func (srv *Server) AbortedWrites() uint64 {
+	return atomic.LoadUint64(&srv.aborted)
+}
+
 // ListenAndServe listens on the TCP network address srv.Addr and then
 // calls Serve to handle requests on incoming connections.  If
 // srv.Addr is blank, ":3868" is used.
@@ -486,9 +733,21 @@ func (srv *Server) Serve(l net.Listener) error {
 			return e
 		}
 		tempDelay = 0
+		if !srv.allowed(rw.RemoteAddr()) || srv.Blacklist.Blocked(rw.RemoteAddr()) {
+			atomic.AddUint64(&srv.rejected, 1)
+			rw.Close()
+			continue
+		}
+		if !srv.beginHandshake() {
+			atomic.AddUint64(&srv.throttled, 1)
+			rw.Close()
+			continue
+		}
 		if c, err := srv.newConn(rw); err != nil {
+			srv.endHandshake()
 			continue
 		} else {
+			c.shaking = true
 			go c.serve()
 		}
 	}
@@ -500,7 +759,7 @@ func (srv *Server) Serve(l net.Listener) error {
 //
 // If handler is nil, DefaultServeMux is used.
 //
-// If dict is nil, dict.Default is used.
+// If dict is nil, dict.DefaultParser is used.
 func ListenAndServe(addr string, handler Handler, dp *dict.Parser) error {
 	server := &Server{Addr: addr, Handler: handler, Dict: dp}
 	return server.ListenAndServe()
@@ -510,9 +769,14 @@ func ListenAndServe(addr string, handler Handler, dp *dict.Parser) error {
 // then calls Serve to handle requests on incoming TLS connections.
 //
 // Filenames containing a certificate and matching private key for
-// the server must be provided. If the certificate is signed by a
-// certificate authority, the certFile should be the concatenation
-// of the server's certificate followed by the CA's certificate.
+// the server must be provided, unless srv.TLSConfig already contains
+// Certificates or a GetCertificate function such as the one of
+// CertReloader. If the certificate is signed by a certificate
+// authority, the certFile should be the concatenation of the server's
+// certificate followed by the CA's certificate.
+//
+// TLS sessions can be resumed by clients using session tickets, whose
+// keys are rotated automatically unless srv.TLSConfig sets them.
 //
 // If srv.Addr is blank, ":3868" is used.
 func (srv *Server) ListenAndServeTLS(certFile, keyFile string) error {
@@ -524,11 +788,14 @@ func (srv *Server) ListenAndServeTLS(certFile, keyFile string) error {
 	if srv.TLSConfig != nil {
 		*config = *srv.TLSConfig
 	}
-	var err error
-	config.Certificates = make([]tls.Certificate, 1)
-	config.Certificates[0], err = tls.LoadX509KeyPair(certFile, keyFile)
-	if err != nil {
-		return err
+	configHasCert := len(config.Certificates) > 0 || config.GetCertificate != nil
+	if !configHasCert || certFile != "" || keyFile != "" {
+		var err error
+		config.Certificates = make([]tls.Certificate, 1)
+		config.Certificates[0], err = tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return err
+		}
 	}
 	conn, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -0,0 +1,7 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package sessionid provides Session-Id generators. See RFC 6733
+// section 8.8 for details on the format of Session-Ids.
+package sessionid
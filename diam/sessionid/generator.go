@@ -0,0 +1,110 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sessionid
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// The Generator interface is implemented by objects that generate
+// unique Session-Ids.
+//
+// Generate must be safe for concurrent use by multiple goroutines.
+type Generator interface {
+	Generate() datatype.UTF8String
+}
+
+// The GeneratorFunc type is an adapter to allow the use of ordinary
+// functions as Session-Id generators.
+type GeneratorFunc func() datatype.UTF8String
+
+// Generate calls f().
+func (f GeneratorFunc) Generate() datatype.UTF8String {
+	return f()
+}
+
+// format returns a Session-Id in the format recommended by RFC 6733:
+//
+//	<DiameterIdentity>;<high 32 bits>;<low 32 bits>[;<optional value>]
+//
+// The high and low parts are formatted using hifmt and lofmt.
+func format(identity datatype.DiameterIdentity, hifmt, lofmt string, hi, lo uint32, optional string) datatype.UTF8String {
+	s := fmt.Sprintf("%s;"+hifmt+";"+lofmt, string(identity), hi, lo)
+	if len(optional) > 0 {
+		s += ";" + optional
+	}
+	return datatype.UTF8String(s)
+}
+
+// Sequential generates Session-Ids as recommended by RFC 6733: the high
+// 32 bits are initialized with the time of creation of the generator,
+// and the low 32 bits are incremented for each new Session-Id.
+//
+// Optional is appended to every Session-Id when set, and is commonly
+// used by vendors to carry the name of the application or process.
+type Sequential struct {
+	Identity datatype.DiameterIdentity
+	Optional string
+
+	hi uint32
+	lo uint32 // accessed atomically
+}
+
+// NewSequential creates and initializes a Sequential generator.
+func NewSequential(identity datatype.DiameterIdentity, optional string) *Sequential {
+	return &Sequential{
+		Identity: identity,
+		Optional: optional,
+		hi:       uint32(time.Now().Unix()),
+	}
+}
+
+// Generate implements the Generator interface.
+func (g *Sequential) Generate() datatype.UTF8String {
+	lo := atomic.AddUint32(&g.lo, 1)
+	return format(g.Identity, "%d", "%d", g.hi, lo, g.Optional)
+}
+
+// KSortable generates Session-Ids that sort lexically in the order they
+// were generated, which is convenient for downstream systems that store
+// or index them. The high 32 bits are the current Unix time in seconds,
+// and the low 32 bits a counter reset every second, both zero padded
+// to 10 digits.
+type KSortable struct {
+	Identity datatype.DiameterIdentity
+	Optional string
+
+	mu  sync.Mutex
+	sec uint32
+	seq uint32
+}
+
+// NewKSortable creates and initializes a KSortable generator.
+func NewKSortable(identity datatype.DiameterIdentity, optional string) *KSortable {
+	return &KSortable{Identity: identity, Optional: optional}
+}
+
+// Generate implements the Generator interface.
+func (g *KSortable) Generate() datatype.UTF8String {
+	now := uint32(time.Now().Unix())
+	g.mu.Lock()
+	switch {
+	case now > g.sec:
+		g.sec, g.seq = now, 0
+	case g.seq == 1<<32-1:
+		// Counter exhausted, borrow from the next second.
+		g.sec, g.seq = g.sec+1, 0
+	default:
+		g.seq++
+	}
+	sec, seq := g.sec, g.seq
+	g.mu.Unlock()
+	return format(g.Identity, "%010d", "%010d", sec, seq, g.Optional)
+}
@@ -0,0 +1,71 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sessionid
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+func testUnique(t *testing.T, g Generator) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seen := make(map[datatype.UTF8String]bool)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				sid := g.Generate()
+				mu.Lock()
+				if seen[sid] {
+					t.Errorf("Duplicate Session-Id: %s", sid)
+				}
+				seen[sid] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSequential(t *testing.T) {
+	g := NewSequential("host.example.com", "app")
+	sid := string(g.Generate())
+	parts := strings.Split(sid, ";")
+	if len(parts) != 4 {
+		t.Fatalf("Unexpected Session-Id format: %q", sid)
+	}
+	if parts[0] != "host.example.com" || parts[2] != "1" || parts[3] != "app" {
+		t.Fatalf("Unexpected Session-Id: %q", sid)
+	}
+	testUnique(t, g)
+}
+
+func TestKSortable(t *testing.T) {
+	g := NewKSortable("host.example.com", "")
+	prev := g.Generate()
+	for i := 0; i < 1000; i++ {
+		sid := g.Generate()
+		if sid <= prev {
+			t.Fatalf("Session-Ids are not sorted: %q <= %q", sid, prev)
+		}
+		prev = sid
+	}
+	if n := len(strings.Split(string(prev), ";")); n != 3 {
+		t.Fatalf("Unexpected Session-Id format: %q", prev)
+	}
+	testUnique(t, g)
+}
+
+func TestGeneratorFunc(t *testing.T) {
+	g := GeneratorFunc(func() datatype.UTF8String { return "foobar" })
+	if sid := g.Generate(); sid != "foobar" {
+		t.Fatalf("Unexpected Session-Id. Want foobar, have %q", sid)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sessionid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// SessionID is a Session-Id split in its parts. See RFC 6733 section 8.8.
+type SessionID struct {
+	Identity datatype.DiameterIdentity // Origin-Host of the session creator
+	High     uint32                    // High 32 bits
+	Low      uint32                    // Low 32 bits
+	Optional string                    // Optional value, may contain ';'
+}
+
+// Parse splits the Session-Id s in its parts:
+//
+//	<DiameterIdentity>;<high 32 bits>;<low 32 bits>[;<optional value>]
+//
+// It returns an error if the DiameterIdentity is empty or contains
+// white space, or if the high and low parts are not 32-bit decimal
+// numbers.
+func Parse(s string) (*SessionID, error) {
+	parts := strings.SplitN(s, ";", 4)
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("invalid Session-Id %q: missing high or low part", s)
+	}
+	if len(parts[0]) == 0 || strings.ContainsAny(parts[0], " \t\r\n") {
+		return nil, fmt.Errorf("invalid Session-Id %q: bad DiameterIdentity", s)
+	}
+	hi, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Session-Id %q: bad high part: %s", s, err)
+	}
+	lo, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Session-Id %q: bad low part: %s", s, err)
+	}
+	sid := &SessionID{
+		Identity: datatype.DiameterIdentity(parts[0]),
+		High:     uint32(hi),
+		Low:      uint32(lo),
+	}
+	if len(parts) == 4 {
+		sid.Optional = parts[3]
+	}
+	return sid, nil
+}
+
+// String returns the Session-Id in its wire format.
+func (sid *SessionID) String() string {
+	return string(format(sid.Identity, "%d", "%d", sid.High, sid.Low, sid.Optional))
+}
@@ -0,0 +1,54 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sessionid
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	sid, err := Parse("host.example.com;1876543210;523;mobile;s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := SessionID{
+		Identity: "host.example.com",
+		High:     1876543210,
+		Low:      523,
+		Optional: "mobile;s1",
+	}
+	if *sid != want {
+		t.Fatalf("Unexpected Session-Id. Want %#v, have %#v", want, *sid)
+	}
+	if s := sid.String(); s != "host.example.com;1876543210;523;mobile;s1" {
+		t.Fatalf("Unexpected Session-Id string: %q", s)
+	}
+}
+
+func TestParseGenerated(t *testing.T) {
+	for _, g := range []Generator{
+		NewSequential("host", ""),
+		NewKSortable("host", "opt"),
+	} {
+		s := string(g.Generate())
+		if _, err := Parse(s); err != nil {
+			t.Fatalf("Failed to parse generated Session-Id %q: %s", s, err)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"host",
+		"host;1",
+		";1;2",
+		"bad host;1;2",
+		"host;x;2",
+		"host;1;4294967296",
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Fatalf("Invalid Session-Id %q was parsed with no error", s)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
+)
+
+// CommandACL is a list of the requests a peer is allowed to send, by
+// command name, such as "CCR" or "DWR". See Settings.PeerACL.
+type CommandACL struct {
+	// Allow lists the commands allowed. When empty, all commands
+	// not listed in Deny are allowed.
+	Allow []string
+
+	// Deny lists the commands denied. It takes precedence over Allow.
+	Deny []string
+}
+
+// Allowed reports whether the command cmd is allowed by the ACL.
+func (acl *CommandACL) Allowed(cmd string) bool {
+	if containsString(acl.Deny, cmd) {
+		return false
+	}
+	return len(acl.Allow) == 0 || containsString(acl.Allow, cmd)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrCommandNotAllowed is reported by the state machine when a peer
+// sends a request that is not allowed by its CommandACL.
+type ErrCommandNotAllowed struct {
+	OriginHost datatype.DiameterIdentity
+	Command    string
+}
+
+// Error implements the error interface.
+func (e *ErrCommandNotAllowed) Error() string {
+	return fmt.Sprintf("peer %s is not allowed to send %s", e.OriginHost, e.Command)
+}
+
+// ACLViolations returns the number of requests rejected by the state
+// machine because they were not allowed by the peer's CommandACL.
+func (sm *StateMachine) ACLViolations() uint64 {
+	return atomic.LoadUint64(&sm.aclViolations)
+}
+
+// allowed enforces the CommandACL of the peer connected to c, if any.
+// Requests not allowed are answered with DIAMETER_COMMAND_UNSUPPORTED,
+// counted, and reported as ErrCommandNotAllowed.
+func (sm *StateMachine) allowed(c diam.Conn, m *diam.Message) bool {
+	if len(sm.cfg.PeerACL) == 0 || m.Header.CommandFlags&diam.RequestFlag == 0 {
+		return true
+	}
+	meta, ok := smpeer.FromContext(c.Context())
+	if !ok {
+		// Peer has not passed the handshake yet.
+		return true
+	}
+	acl, ok := sm.cfg.PeerACL[meta.OriginHost]
+	if !ok || acl == nil {
+		return true
+	}
+	cmd := fmt.Sprintf("%d", m.Header.CommandCode)
+	dcmd, err := m.Dictionary().FindCommand(
+		m.Header.ApplicationID,
+		m.Header.CommandCode,
+	)
+	if err == nil {
+		cmd = dcmd.Short + "R"
+	}
+	if acl.Allowed(cmd) {
+		return true
+	}
+	atomic.AddUint64(&sm.aclViolations, 1)
+	sm.Error(&diam.ErrorReport{
+		Conn:    c,
+		Message: m,
+		Error:   &ErrCommandNotAllowed{OriginHost: meta.OriginHost, Command: cmd},
+	})
+	sm.writeResultCode(c, m, diam.CommandUnsupported)
+	return false
+}
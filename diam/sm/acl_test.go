@@ -0,0 +1,83 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestCommandACL(t *testing.T) {
+	acl := &CommandACL{Allow: []string{"CCR", "DWR"}}
+	for cmd, want := range map[string]bool{
+		"CCR": true,
+		"DWR": true,
+		"ACR": false,
+	} {
+		if v := acl.Allowed(cmd); v != want {
+			t.Fatalf("Unexpected result for %s. Want %t, have %t", cmd, want, v)
+		}
+	}
+	acl = &CommandACL{Deny: []string{"ACR"}}
+	if acl.Allowed("ACR") || !acl.Allowed("CCR") {
+		t.Fatalf("Unexpected result for deny list %v", acl.Deny)
+	}
+}
+
+func TestStateMachine_PeerACL(t *testing.T) {
+	settings := *serverSettings
+	settings.PeerACL = map[datatype.DiameterIdentity]*CommandACL{
+		clientSettings.OriginHost: {Allow: []string{"DWR"}},
+	}
+	sm := New(&settings)
+	sm.HandleFunc("CCR", func(c diam.Conn, m *diam.Message) {
+		m.Answer(diam.Success).WriteTo(c)
+	})
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	cli.Handler.HandleFunc("CCA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if _, err = newCCR(ccInitialRequest).WriteTo(c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-mc:
+		if !testResultCode(resp, diam.CommandUnsupported) {
+			t.Fatalf("Unexpected result code.\n%s", resp)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No CCA received")
+	}
+	select {
+	case err := <-sm.ErrorReports():
+		if _, ok := err.Error.(*ErrCommandNotAllowed); !ok {
+			t.Fatalf("Unexpected error: %v", err.Error)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No error reported")
+	}
+	if n := sm.ACLViolations(); n != 1 {
+		t.Fatalf("Unexpected # of ACL violations. Want 1, have %d", n)
+	}
+}
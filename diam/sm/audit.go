@@ -0,0 +1,35 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smaudit"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
+)
+
+// audit appends the capabilities exchange m, received on c, to the
+// AuditLog of the settings, if any. The code is the Result-Code of the
+// CEA and meta is the peer metadata when the exchange succeeded.
+func (sm *StateMachine) audit(c diam.Conn, m *diam.Message, code uint32, meta *smpeer.Metadata, err error) {
+	if sm.cfg.AuditLog == nil {
+		return
+	}
+	r := smaudit.NewRecord(c, m)
+	r.ResultCode = code
+	if meta != nil {
+		r.Applications = meta.Applications
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	if err = sm.cfg.AuditLog.Append(r); err != nil {
+		sm.Error(&diam.ErrorReport{
+			Conn:    c,
+			Message: m,
+			Error:   err,
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smaudit"
+)
+
+var auditKey = []byte("secret")
+
+// lineWriter sends each write to a channel.
+type lineWriter chan []byte
+
+func (w lineWriter) Write(b []byte) (int, error) {
+	w <- append([]byte(nil), b...)
+	return len(b), nil
+}
+
+func (w lineWriter) next(t *testing.T, log *bytes.Buffer) *smaudit.Entry {
+	select {
+	case b := <-w:
+		log.Write(b)
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for audit log entry")
+	}
+	e, err := smaudit.Verify(bytes.NewReader(log.Bytes()), auditKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestAuditLog(t *testing.T) {
+	srvlog := make(lineWriter, 1)
+	ss := *serverSettings
+	ss.AuditLog = smaudit.NewLog(srvlog, auditKey)
+	srv := diamtest.NewServer(New(&ss), dict.Default)
+	defer srv.Close()
+	var clilog bytes.Buffer
+	cs := *clientSettings
+	cs.AuditLog = smaudit.NewLog(&clilog, auditKey)
+	cli := &Client{
+		Handler: New(&cs),
+		AcctApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(1001)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	cea, err := smaudit.Verify(&clilog, auditKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log bytes.Buffer
+	cer := srvlog.next(t, &log)
+	for _, test := range []struct {
+		Entry   *smaudit.Entry
+		Command string
+		Host    datatype.DiameterIdentity
+	}{
+		{cer, "CER", clientSettings.OriginHost},
+		{cea, "CEA", serverSettings.OriginHost},
+	} {
+		e := test.Entry
+		if e.Seq != 1 || e.Command != test.Command {
+			t.Fatalf("Unexpected entry %d: %s", e.Seq, e.Command)
+		}
+		if e.OriginHost != string(test.Host) {
+			t.Fatalf("Unexpected OriginHost. Want %q, have %q",
+				test.Host, e.OriginHost)
+		}
+		if e.ResultCode != diam.Success || e.Error != "" {
+			t.Fatalf("Unexpected exchange: %#v", e.Record)
+		}
+		if len(e.Applications) != 1 || e.Applications[0] != 1001 {
+			t.Fatalf("Unexpected Applications: %v", e.Applications)
+		}
+		if e.RemoteAddr == "" || len(e.HostIPAddress) != 1 || e.TLS != nil {
+			t.Fatalf("Unexpected connection details: %#v", e.Record)
+		}
+	}
+	// Failed exchanges are also recorded.
+	rc, err := diam.Dial(srv.Address, nil, dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	m := diam.NewRequest(diam.CapabilitiesExchange, 0, dict.Default)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientSettings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+	m.NewAVP(avp.HostIPAddress, avp.Mbit, 0, localhostAddress)
+	m.NewAVP(avp.VendorID, avp.Mbit, 0, clientSettings.VendorID)
+	m.NewAVP(avp.ProductName, 0, 0, clientSettings.ProductName)
+	m.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(1))
+	m.NewAVP(avp.InbandSecurityID, avp.Mbit, 0, datatype.Unsigned32(1))
+	m.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(1001))
+	if _, err = m.WriteTo(rc); err != nil {
+		t.Fatal(err)
+	}
+	e := srvlog.next(t, &log)
+	if e.Seq != 2 || e.ResultCode != diam.NoCommonSecurity || e.Error == "" {
+		t.Fatalf("Unexpected failed exchange: %#v", e)
+	}
+	if e.Applications != nil {
+		t.Fatalf("Unexpected Applications: %v", e.Applications)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
+)
+
+// Capabilities are the capabilities advertised by a peer in the CER or
+// CEA of its last successful handshake.
+type Capabilities struct {
+	OriginHost        datatype.DiameterIdentity
+	OriginRealm       datatype.DiameterIdentity
+	VendorID          datatype.Unsigned32
+	ProductName       datatype.UTF8String
+	FirmwareRevision  datatype.Unsigned32
+	SupportedVendorID []datatype.Unsigned32
+	Applications      []uint32 // Acct or Auth IDs supported by the peer.
+}
+
+// capabilitiesFromCER creates a Capabilities object from data in the CER.
+func capabilitiesFromCER(cer *smparser.CER) *Capabilities {
+	return &Capabilities{
+		OriginHost:        cer.OriginHost,
+		OriginRealm:       cer.OriginRealm,
+		VendorID:          cer.VendorID,
+		ProductName:       cer.ProductName,
+		FirmwareRevision:  cer.FirmwareRevision,
+		SupportedVendorID: cer.SupportedVendorID,
+		Applications:      cer.Applications(),
+	}
+}
+
+// capabilitiesFromCEA creates a Capabilities object from data in the CEA.
+func capabilitiesFromCEA(cea *smparser.CEA) *Capabilities {
+	return &Capabilities{
+		OriginHost:        cea.OriginHost,
+		OriginRealm:       cea.OriginRealm,
+		VendorID:          cea.VendorID,
+		ProductName:       cea.ProductName,
+		FirmwareRevision:  cea.FirmwareRevision,
+		SupportedVendorID: cea.SupportedVendorID,
+		Applications:      cea.Applications(),
+	}
+}
+
+// Equal reports whether c and o advertise the same capabilities.
+// The order of vendor and application IDs is not relevant.
+func (c *Capabilities) Equal(o *Capabilities) bool {
+	if c.OriginHost != o.OriginHost ||
+		c.OriginRealm != o.OriginRealm ||
+		c.VendorID != o.VendorID ||
+		c.ProductName != o.ProductName ||
+		c.FirmwareRevision != o.FirmwareRevision {
+		return false
+	}
+	a := make([]uint32, len(c.SupportedVendorID))
+	for n, v := range c.SupportedVendorID {
+		a[n] = uint32(v)
+	}
+	b := make([]uint32, len(o.SupportedVendorID))
+	for n, v := range o.SupportedVendorID {
+		b[n] = uint32(v)
+	}
+	return equalIDs(a, b) && equalIDs(c.Applications, o.Applications)
+}
+
+// equalIDs reports whether a and b contain the same IDs in any order.
+func equalIDs(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]uint32(nil), a...)
+	sb := append([]uint32(nil), b...)
+	sort.Sort(uint32Slice(sa))
+	sort.Sort(uint32Slice(sb))
+	for n := range sa {
+		if sa[n] != sb[n] {
+			return false
+		}
+	}
+	return true
+}
+
+type uint32Slice []uint32
+
+func (s uint32Slice) Len() int           { return len(s) }
+func (s uint32Slice) Less(i, j int) bool { return s[i] < s[j] }
+func (s uint32Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// PeerCapabilities returns the capabilities advertised by the peer
+// identified by host in its last successful handshake. They remain
+// available after the peer disconnects.
+func (sm *StateMachine) PeerCapabilities(host datatype.DiameterIdentity) (*Capabilities, bool) {
+	sm.cmu.RLock()
+	defer sm.cmu.RUnlock()
+	caps, ok := sm.caps[host]
+	return caps, ok
+}
+
+// updateCapabilities caches the capabilities of a peer that passed the
+// handshake, and reports ErrCapabilitiesChanged when they differ from
+// the ones cached from a previous handshake.
+func (sm *StateMachine) updateCapabilities(c diam.Conn, m *diam.Message, caps *Capabilities) {
+	sm.cmu.Lock()
+	old, ok := sm.caps[caps.OriginHost]
+	sm.caps[caps.OriginHost] = caps
+	sm.cmu.Unlock()
+	if ok && !old.Equal(caps) {
+		sm.Error(&diam.ErrorReport{
+			Conn:    c,
+			Message: m,
+			Error:   &ErrCapabilitiesChanged{Old: old, New: caps},
+		})
+	}
+}
+
+// ErrCapabilitiesChanged is reported when a peer reconnects, or
+// renegotiates its capabilities on an open connection, and
+// advertises capabilities that differ from its previous handshake.
+// It does not prevent the handshake from succeeding.
+type ErrCapabilitiesChanged struct {
+	Old *Capabilities
+	New *Capabilities
+}
+
+// Error implements the error interface.
+func (e *ErrCapabilitiesChanged) Error() string {
+	return fmt.Sprintf("peer %s changed capabilities: %+v => %+v",
+		e.New.OriginHost, *e.Old, *e.New)
+}
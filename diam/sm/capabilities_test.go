@@ -0,0 +1,93 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestCapabilities_Equal(t *testing.T) {
+	a := &Capabilities{
+		OriginHost:        "foobar",
+		SupportedVendorID: []datatype.Unsigned32{1, 2},
+		Applications:      []uint32{4, 0},
+	}
+	b := &Capabilities{
+		OriginHost:        "foobar",
+		SupportedVendorID: []datatype.Unsigned32{2, 1},
+		Applications:      []uint32{0, 4},
+	}
+	if !a.Equal(b) {
+		t.Fatalf("Unexpected difference between %+v and %+v", a, b)
+	}
+	b.Applications = []uint32{0}
+	if a.Equal(b) {
+		t.Fatalf("Unexpected equality between %+v and %+v", a, b)
+	}
+}
+
+func TestStateMachine_PeerCapabilities(t *testing.T) {
+	sm := New(serverSettings)
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	dial := func(settings *Settings) {
+		cli := &Client{
+			Handler: New(settings),
+			SupportedVendorID: []*diam.AVP{
+				diam.NewAVP(avp.SupportedVendorID, avp.Mbit, 0, settings.VendorID),
+			},
+			AcctApplicationID: []*diam.AVP{
+				diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(0)),
+			},
+		}
+		c, err := cli.Dial(srv.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Close()
+	}
+	dial(clientSettings)
+	var caps *Capabilities
+	for i := 0; i < 100; i++ {
+		var ok bool
+		if caps, ok = sm.PeerCapabilities(clientSettings.OriginHost); ok {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if caps == nil {
+		t.Fatal("Peer capabilities were not cached")
+	}
+	if caps.FirmwareRevision != clientSettings.FirmwareRevision {
+		t.Fatalf("Unexpected Firmware-Revision. Want %d, have %d",
+			clientSettings.FirmwareRevision, caps.FirmwareRevision)
+	}
+	if len(caps.SupportedVendorID) != 1 || caps.SupportedVendorID[0] != clientSettings.VendorID {
+		t.Fatalf("Unexpected Supported-Vendor-Id: %v", caps.SupportedVendorID)
+	}
+	upgraded := *clientSettings
+	upgraded.FirmwareRevision++
+	dial(&upgraded)
+	select {
+	case er := <-sm.ErrorReports():
+		e, ok := er.Error.(*ErrCapabilitiesChanged)
+		if !ok {
+			t.Fatal(er)
+		}
+		if e.New.FirmwareRevision != upgraded.FirmwareRevision {
+			t.Fatalf("Unexpected Firmware-Revision. Want %d, have %d",
+				upgraded.FirmwareRevision, e.New.FirmwareRevision)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for capabilities change report")
+	}
+}
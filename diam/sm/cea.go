@@ -7,9 +7,9 @@ package sm
 import (
 	"fmt"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
-	"github.com/fiorix/go-diameter/diam/sm/smpeer"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
 )
 
 // handleCEA handles Capabilities-Exchange-Answer messages.
@@ -17,15 +17,22 @@ func handleCEA(sm *StateMachine, errc chan error) diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		cea := new(smparser.CEA)
 		if err := cea.Parse(m); err != nil {
+			sm.audit(c, m, cea.ResultCode, nil, err)
 			errc <- err
 			return
 		}
 		if cea.ResultCode != diam.Success {
-			errc <- &ErrFailedResultCode{Code: cea.ResultCode}
+			err := &ErrFailedResultCode{Code: cea.ResultCode}
+			sm.audit(c, m, cea.ResultCode, nil, err)
+			errc <- err
 			return
 		}
+		sm.setPeerDictionary(c, cea.OriginHost)
+		sm.updateCapabilities(c, m, capabilitiesFromCEA(cea))
 		meta := smpeer.FromCEA(cea)
+		meta.SetConn(c)
 		c.SetContext(smpeer.NewContext(c.Context(), meta))
+		sm.audit(c, m, cea.ResultCode, meta, nil)
 		// Notify about peer passing the handshake.
 		select {
 		case sm.hsNotifyc <- c:
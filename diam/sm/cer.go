@@ -8,11 +8,11 @@ import (
 	"fmt"
 	"net"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
-	"github.com/fiorix/go-diameter/diam/sm/smpeer"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
 )
 
 // handleCER handles Capabilities-Exchange-Request messages.
@@ -20,27 +20,39 @@ import (
 // If mandatory AVPs such as Origin-Host, Origin-Realm, or
 // Origin-State-Id are missing, we close the connection.
 //
+// A CER received after the handshake is ignored as a retransmission,
+// unless Settings.AllowRenegotiation is set. See handleRenegotiation.
+//
 // See RFC 6733 section 5.3 for details.
 func handleCER(sm *StateMachine) diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		ctx := c.Context()
-		if _, ok := smpeer.FromContext(ctx); ok {
-			// Ignore retransmission.
+		if meta, ok := smpeer.FromContext(ctx); ok {
+			if sm.cfg.AllowRenegotiation {
+				handleRenegotiation(sm, c, m, meta)
+			}
+			// Otherwise ignore retransmission.
 			return
 		}
 		cer := new(smparser.CER)
 		failedAVP, err := cer.Parse(m)
+		if err == nil {
+			failedAVP, err = sm.checkRoles(cer)
+		}
 		if err != nil {
+			var code uint32
 			if failedAVP != nil {
-				err = errorCEA(sm, c, m, cer, failedAVP)
-				if err != nil {
+				code = errorCEACode(cer, failedAVP)
+				if werr := errorCEA(sm, c, m, cer, failedAVP); werr != nil {
 					sm.Error(&diam.ErrorReport{
 						Conn:    c,
 						Message: m,
-						Error:   err,
+						Error:   werr,
 					})
 				}
 			}
+			sm.audit(c, m, code, nil, err)
+			sm.cfg.Blacklist.Fail(c.RemoteAddr(), err)
 			c.Close()
 			return
 		}
@@ -51,10 +63,16 @@ func handleCER(sm *StateMachine) diam.HandlerFunc {
 				Message: m,
 				Error:   err,
 			})
+			sm.audit(c, m, diam.Success, nil, err)
 			return
 		}
+		sm.cfg.Blacklist.Reset(c.RemoteAddr())
+		sm.setPeerDictionary(c, cer.OriginHost)
+		sm.updateCapabilities(c, m, capabilitiesFromCER(cer))
 		meta := smpeer.FromCER(cer)
+		meta.SetConn(c)
 		c.SetContext(smpeer.NewContext(ctx, meta))
+		sm.audit(c, m, diam.Success, meta, nil)
 		// Notify about peer passing the handshake.
 		select {
 		case sm.hsNotifyc <- c:
@@ -63,6 +81,62 @@ func handleCER(sm *StateMachine) diam.HandlerFunc {
 	}
 }
 
+// handleRenegotiation handles a CER received on a connection that has
+// already passed the handshake, renegotiating the capabilities of the
+// peer. Unlike the initial handshake, failures are answered but do not
+// close the connection, and the peer keeps its previous capabilities.
+// The peer may not change its Origin-Host, which is answered with
+// DIAMETER_UNKNOWN_PEER.
+func handleRenegotiation(sm *StateMachine, c diam.Conn, m *diam.Message, meta *smpeer.Metadata) {
+	cer := new(smparser.CER)
+	failedAVP, err := cer.Parse(m)
+	if err == nil {
+		failedAVP, err = sm.checkRoles(cer)
+	}
+	switch {
+	case err != nil && failedAVP != nil:
+		sm.audit(c, m, errorCEACode(cer, failedAVP), nil, err)
+		err = errorCEA(sm, c, m, cer, failedAVP)
+	case err != nil:
+		sm.audit(c, m, 0, nil, err)
+	case cer.OriginHost != meta.OriginHost:
+		err = &ErrUnexpectedOriginHost{Want: meta.OriginHost, Have: cer.OriginHost}
+		sm.writeResultCode(c, m, diam.UnknownPeer)
+		sm.audit(c, m, diam.UnknownPeer, nil, err)
+	default:
+		if err = successCEA(sm, c, m, cer); err != nil {
+			sm.audit(c, m, diam.Success, nil, err)
+			break
+		}
+		sm.updateCapabilities(c, m, capabilitiesFromCER(cer))
+		meta = smpeer.FromCER(cer)
+		meta.SetConn(c)
+		c.SetContext(smpeer.NewContext(c.Context(), meta))
+		sm.audit(c, m, diam.Success, meta, nil)
+	}
+	if err != nil {
+		sm.Error(&diam.ErrorReport{
+			Conn:    c,
+			Message: m,
+			Error:   err,
+		})
+	}
+}
+
+// ErrUnexpectedOriginHost is reported when a peer renegotiating its
+// capabilities advertises an Origin-Host different from the one of
+// its initial handshake.
+type ErrUnexpectedOriginHost struct {
+	Want datatype.DiameterIdentity
+	Have datatype.DiameterIdentity
+}
+
+// Error implements the error interface.
+func (e *ErrUnexpectedOriginHost) Error() string {
+	return fmt.Sprintf("unexpected Origin-Host in CER: want %s, have %s",
+		e.Want, e.Have)
+}
+
 // errorCEA sends an error answer indicating that the CER failed due to
 // an unsupported (acct/auth) application, and includes the AVP that
 // caused the failure in the message.
@@ -71,19 +145,14 @@ func errorCEA(sm *StateMachine, c diam.Conn, m *diam.Message, cer *smparser.CER,
 	if err != nil {
 		return fmt.Errorf("failed to parse own ip %q: %s", c.LocalAddr(), err)
 	}
-	var a *diam.Message
-	if failedAVP == cer.InbandSecurityID {
-		a = m.Answer(diam.NoCommonSecurity)
-	} else {
-		a = m.Answer(diam.NoCommonApplication)
-	}
+	a := m.Answer(errorCEACode(cer, failedAVP))
 	a.Header.CommandFlags |= diam.ErrorFlag
 	a.NewAVP(avp.OriginHost, avp.Mbit, 0, sm.cfg.OriginHost)
 	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, sm.cfg.OriginRealm)
 	a.NewAVP(avp.HostIPAddress, avp.Mbit, 0, datatype.Address(net.ParseIP(hostIP)))
 	a.NewAVP(avp.VendorID, avp.Mbit, 0, sm.cfg.VendorID)
 	a.NewAVP(avp.ProductName, 0, 0, sm.cfg.ProductName)
-	a.AddAVP(cer.OriginStateID)
+	a.NewAVP(avp.OriginStateID, avp.Mbit, 0, sm.OriginStateID())
 	a.NewAVP(avp.FailedAVP, avp.Mbit, 0, &diam.GroupedAVP{
 		AVP: []*diam.AVP{failedAVP},
 	})
@@ -92,6 +161,15 @@ func errorCEA(sm *StateMachine, c diam.Conn, m *diam.Message, cer *smparser.CER,
 	return err
 }
 
+// errorCEACode returns the Result-Code of the error answer to a CER
+// that failed due to failedAVP.
+func errorCEACode(cer *smparser.CER, failedAVP *diam.AVP) uint32 {
+	if failedAVP == cer.InbandSecurityID {
+		return diam.NoCommonSecurity
+	}
+	return diam.NoCommonApplication
+}
+
 // successCEA sends a success answer indicating that the CER was successfuly
 // parsed and accepted by the server.
 func successCEA(sm *StateMachine, c diam.Conn, m *diam.Message, cer *smparser.CER) error {
@@ -105,21 +183,9 @@ func successCEA(sm *StateMachine, c diam.Conn, m *diam.Message, cer *smparser.CE
 	a.NewAVP(avp.HostIPAddress, avp.Mbit, 0, datatype.Address(net.ParseIP(hostIP)))
 	a.NewAVP(avp.VendorID, avp.Mbit, 0, sm.cfg.VendorID)
 	a.NewAVP(avp.ProductName, 0, 0, sm.cfg.ProductName)
-	a.AddAVP(cer.OriginStateID)
-	if cer.AcctApplicationID != nil {
-		for _, acct := range cer.AcctApplicationID {
-			a.AddAVP(acct)
-		}
-	}
-	if cer.AuthApplicationID != nil {
-		for _, auth := range cer.AuthApplicationID {
-			a.AddAVP(auth)
-		}
-	}
-	if cer.VendorSpecificApplicationID != nil {
-		for _, vs := range cer.VendorSpecificApplicationID {
-			a.AddAVP(vs)
-		}
+	a.NewAVP(avp.OriginStateID, avp.Mbit, 0, sm.OriginStateID())
+	for _, app := range sm.commonApplications(cer) {
+		a.AddAVP(app)
 	}
 	a.NewAVP(avp.FirmwareRevision, avp.Mbit, 0, sm.cfg.FirmwareRevision)
 	_, err = a.WriteTo(c)
@@ -8,12 +8,12 @@ import (
 	"testing"
 	"time"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/diamtest"
-	"github.com/fiorix/go-diameter/diam/dict"
-	"github.com/fiorix/go-diameter/diam/sm/smpeer"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
 )
 
 // These tests use dictionary, settings and functions from sm_test.go.
@@ -63,6 +63,19 @@ func TestHandleCER_HandshakeMetadata(t *testing.T) {
 			t.Fatalf("Unexpected OriginRealm. Want %q, have %q",
 				clientSettings.OriginRealm, meta.OriginRealm)
 		}
+		if meta.Transport != "tcp" {
+			t.Fatalf("Unexpected Transport. Want \"tcp\", have %q",
+				meta.Transport)
+		}
+		if meta.RemoteAddr == nil || meta.LocalAddr == nil {
+			t.Fatal("Missing connection addresses in metadata")
+		}
+		if meta.TLS != nil {
+			t.Fatal("Unexpected TLS state in metadata")
+		}
+		if !meta.Supports(1001) {
+			t.Fatal("Application 1001 not in metadata")
+		}
 	}
 }
 
@@ -432,3 +445,131 @@ func TestHandleCER_InbandSecurity(t *testing.T) {
 		t.Fatal("No message received")
 	}
 }
+
+func TestHandleCER_Renegotiation(t *testing.T) {
+	settings := *serverSettings
+	settings.AllowRenegotiation = true
+	sm := New(&settings)
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	mux := diam.NewServeMux()
+	mux.HandleFunc("CEA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	cli, err := diam.Dial(srv.Address, mux, dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	for _, test := range []struct {
+		OriginHost datatype.DiameterIdentity
+		AppAVP     uint32
+		AppID      uint32
+		ResultCode uint32
+	}{
+		{clientSettings.OriginHost, avp.AcctApplicationID, 1001, diam.Success},
+		{clientSettings.OriginHost, avp.AuthApplicationID, 1002, diam.Success},
+		{"other", avp.AuthApplicationID, 1002, diam.UnknownPeer},
+	} {
+		m := diam.NewRequest(diam.CapabilitiesExchange, 0, dict.Default)
+		m.NewAVP(avp.OriginHost, avp.Mbit, 0, test.OriginHost)
+		m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+		m.NewAVP(avp.HostIPAddress, avp.Mbit, 0, localhostAddress)
+		m.NewAVP(avp.VendorID, avp.Mbit, 0, clientSettings.VendorID)
+		m.NewAVP(avp.ProductName, 0, 0, clientSettings.ProductName)
+		m.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(1))
+		m.NewAVP(test.AppAVP, avp.Mbit, 0, datatype.Unsigned32(test.AppID))
+		m.NewAVP(avp.FirmwareRevision, avp.Mbit, 0, clientSettings.FirmwareRevision)
+		if _, err = m.WriteTo(cli); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case resp := <-mc:
+			if !testResultCode(resp, test.ResultCode) {
+				t.Fatalf("Unexpected result code for application %d.\n%s",
+					test.AppID, resp)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("No message received")
+		}
+	}
+	caps, ok := sm.PeerCapabilities(clientSettings.OriginHost)
+	if !ok {
+		t.Fatal("No capabilities found for peer")
+	}
+	if len(caps.Applications) != 1 || caps.Applications[0] != 1002 {
+		t.Fatalf("Unexpected applications. Want [1002], have %v", caps.Applications)
+	}
+}
+
+func TestHandleCER_Blacklist(t *testing.T) {
+	settings := *serverSettings
+	settings.Blacklist = diam.NewBlacklist(1, time.Minute)
+	srv := diamtest.NewServer(New(&settings), dict.Default)
+	defer srv.Close()
+	cli, err := diam.Dial(srv.Address, diam.NewServeMux(), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	m := diam.NewRequest(diam.CapabilitiesExchange, 0, dict.Default)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientSettings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+	m.NewAVP(avp.HostIPAddress, avp.Mbit, 0, localhostAddress)
+	m.NewAVP(avp.VendorID, avp.Mbit, 0, clientSettings.VendorID)
+	m.NewAVP(avp.ProductName, 0, 0, clientSettings.ProductName)
+	m.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(1))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(1000))
+	if _, err = m.WriteTo(cli); err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; len(settings.Blacklist.List()) == 0; n++ {
+		if n == 100 {
+			t.Fatal("Peer was not blocked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestHandleCER_SharedBlacklist(t *testing.T) {
+	settings := *serverSettings
+	settings.Blacklist = diam.NewBlacklist(2, time.Minute)
+	srv := diamtest.NewUnstartedServer(New(&settings), dict.Default)
+	srv.Config.Blacklist = settings.Blacklist
+	srv.Start()
+	defer srv.Close()
+	failCER := func() {
+		cli, err := diam.Dial(srv.Address, diam.NewServeMux(), dict.Default)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer cli.Close()
+		m := diam.NewRequest(diam.CapabilitiesExchange, 0, dict.Default)
+		m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientSettings.OriginHost)
+		m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+		m.NewAVP(avp.HostIPAddress, avp.Mbit, 0, localhostAddress)
+		m.NewAVP(avp.VendorID, avp.Mbit, 0, clientSettings.VendorID)
+		m.NewAVP(avp.ProductName, 0, 0, clientSettings.ProductName)
+		m.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(1))
+		m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(1000))
+		if _, err = m.WriteTo(cli); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-cli.(diam.CloseNotifier).CloseNotify():
+		case <-time.After(time.Second):
+			t.Fatal("Connection was not closed by the server")
+		}
+		// Let the server read from the closed connection.
+		time.Sleep(50 * time.Millisecond)
+	}
+	failCER()
+	if l := settings.Blacklist.List(); len(l) != 0 {
+		t.Fatalf("Peer blocked after one failed handshake: %v", l)
+	}
+	failCER()
+	if len(settings.Blacklist.List()) == 0 {
+		t.Fatal("Peer not blocked after two failed handshakes")
+	}
+}
@@ -9,11 +9,11 @@ import (
 	"net"
 	"time"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/dict"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
 )
 
 var (
@@ -42,7 +42,7 @@ var (
 // enabled by setting MaxRetransmits to a number greater than zero, and
 // watchdog is enabled by setting EnableWatchdog to true.
 type Client struct {
-	Dict                        *dict.Parser  // Dictionary parser (uses dict.Default if unset)
+	Dict                        *dict.Parser  // Dictionary parser (uses dict.DefaultParser if unset)
 	Handler                     *StateMachine // Message handler
 	MaxRetransmits              uint          // Max number of retransmissions before aborting
 	RetransmitInterval          time.Duration // Interval between retransmissions (default 1s)
@@ -87,7 +87,7 @@ func (cli *Client) validate() error {
 		return ErrMissingStateMachine
 	}
 	if cli.Dict == nil {
-		cli.Dict = dict.Default
+		cli.Dict = dict.DefaultParser()
 	}
 	if cli.RetransmitInterval == 0 {
 		// Set default RetransmitInterval.
@@ -121,7 +121,9 @@ func (cli *Client) handshake(c diam.Conn) (diam.Conn, error) {
 	cli.Handler.mux.HandleFunc("CER", func(c diam.Conn, m *diam.Message) {})
 	// Handle CEA and DWA.
 	errc := make(chan error)
-	dwac := make(chan struct{})
+	// Buffered so a DWA that arrives before waitDWA is ready to
+	// receive it is not discarded as unmatched.
+	dwac := make(chan *diam.Message, 1)
 	cli.Handler.mux.Handle("CEA", handleCEA(cli.Handler, errc))
 	cli.Handler.mux.Handle("DWA", handshakeOK(handleDWA(cli.Handler, dwac)))
 	for i := 0; i < (int(cli.MaxRetransmits) + 1); i++ {
@@ -153,26 +155,25 @@ func (cli *Client) makeCER(ip net.IP) *diam.Message {
 	m.NewAVP(avp.HostIPAddress, avp.Mbit, 0, datatype.Address(ip))
 	m.NewAVP(avp.VendorID, avp.Mbit, 0, cli.Handler.cfg.VendorID)
 	m.NewAVP(avp.ProductName, 0, 0, cli.Handler.cfg.ProductName)
-	stateid := datatype.Unsigned32(uint32(time.Now().Unix()))
-	m.NewAVP(avp.OriginStateID, avp.Mbit, 0, stateid)
+	m.NewAVP(avp.OriginStateID, avp.Mbit, 0, cli.Handler.OriginStateID())
 	if cli.SupportedVendorID != nil {
 		for _, a := range cli.SupportedVendorID {
 			m.AddAVP(a)
 		}
 	}
-	if cli.AuthApplicationID != nil {
-		for _, a := range cli.AuthApplicationID {
+	for _, a := range cli.AuthApplicationID {
+		if cli.Handler.advertises(a) {
 			m.AddAVP(a)
 		}
 	}
 	m.NewAVP(avp.InbandSecurityID, avp.Mbit, 0, datatype.Unsigned32(0))
-	if cli.AcctApplicationID != nil {
-		for _, a := range cli.AcctApplicationID {
+	for _, a := range cli.AcctApplicationID {
+		if cli.Handler.advertises(a) {
 			m.AddAVP(a)
 		}
 	}
-	if cli.VendorSpecificApplicationID != nil {
-		for _, a := range cli.VendorSpecificApplicationID {
+	for _, a := range cli.VendorSpecificApplicationID {
+		if cli.Handler.advertises(a) {
 			m.AddAVP(a)
 		}
 	}
@@ -180,41 +181,55 @@ func (cli *Client) makeCER(ip net.IP) *diam.Message {
 	return m
 }
 
-func (cli *Client) watchdog(c diam.Conn, dwac chan struct{}) {
+func (cli *Client) watchdog(c diam.Conn, dwac chan *diam.Message) {
 	disconnect := c.(diam.CloseNotifier).CloseNotify()
-	var osid uint32
 	for {
 		select {
 		case <-disconnect:
 			return
 		case <-time.After(cli.WatchdogInterval):
-			osid++
-			cli.dwr(c, osid, dwac)
+			cli.dwr(c, dwac)
 		}
 	}
 }
 
-func (cli *Client) dwr(c diam.Conn, osid uint32, dwac chan struct{}) {
-	m := cli.makeDWR(osid)
+func (cli *Client) dwr(c diam.Conn, dwac chan *diam.Message) {
+	m := cli.makeDWR()
 	for i := 0; i < (int(cli.MaxRetransmits) + 1); i++ {
 		_, err := m.WriteTo(c)
 		if err != nil {
 			return
 		}
-		select {
-		case <-dwac:
+		if cli.waitDWA(c, m, dwac) {
 			return
-		case <-time.After(cli.RetransmitInterval):
 		}
 	}
 	// Watchdog failed, disconnect.
 	c.Close()
 }
 
-func (cli *Client) makeDWR(osid uint32) *diam.Message {
+// waitDWA waits for the answer to the DWR m, discarding answers that
+// do not match its Hop-by-Hop Identifier. It reports whether the answer
+// arrived within the retransmit interval.
+func (cli *Client) waitDWA(c diam.Conn, m *diam.Message, dwac chan *diam.Message) bool {
+	timeout := time.After(cli.RetransmitInterval)
+	for {
+		select {
+		case a := <-dwac:
+			if a.Header.HopByHopID == m.Header.HopByHopID {
+				return true
+			}
+			cli.Handler.unmatchedAnswer(c, a)
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+func (cli *Client) makeDWR() *diam.Message {
 	m := diam.NewRequest(diam.DeviceWatchdog, 0, cli.Dict)
 	m.NewAVP(avp.OriginHost, avp.Mbit, 0, cli.Handler.cfg.OriginHost)
 	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, cli.Handler.cfg.OriginRealm)
-	m.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(osid))
+	m.NewAVP(avp.OriginStateID, avp.Mbit, 0, cli.Handler.OriginStateID())
 	return m
 }
@@ -10,12 +10,12 @@ import (
 	"testing"
 	"time"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/diamtest"
-	"github.com/fiorix/go-diameter/diam/dict"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
 )
 
 func TestClient_Dial_MissingStateMachine(t *testing.T) {
@@ -232,7 +232,7 @@ func TestClient_Watchdog(t *testing.T) {
 		t.Fatal(err)
 	}
 	defer c.Close()
-	resp := make(chan struct{}, 1)
+	resp := make(chan *diam.Message, 1)
 	dwa := handleDWA(cli.Handler, resp)
 	cli.Handler.mux.HandleFunc("DWA", func(c diam.Conn, m *diam.Message) {
 		dwa(c, m)
@@ -273,3 +273,153 @@ func TestClient_Watchdog_Timeout(t *testing.T) {
 		t.Fatal("Timeout waiting for watchdog to disconnect client")
 	}
 }
+
+func TestClient_Handshake_PeerDict(t *testing.T) {
+	srv := diamtest.NewServer(New(serverSettings), dict.Default)
+	defer srv.Close()
+	peerDict, err := dict.NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := *clientSettings
+	settings.PeerDict = map[datatype.DiameterIdentity]*dict.Parser{
+		serverSettings.OriginHost: peerDict,
+	}
+	cli := &Client{
+		Handler: New(&settings),
+		AcctApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(0)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if c.Dictionary() != peerDict {
+		t.Fatal("Peer dictionary was not set after the handshake")
+	}
+}
+
+func TestClient_Watchdog_PeerRestarted(t *testing.T) {
+	mux := diam.NewServeMux()
+	mux.HandleFunc("CER", func(c diam.Conn, m *diam.Message) {
+		a := m.Answer(diam.Success)
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, serverSettings.OriginHost)
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, serverSettings.OriginRealm)
+		a.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(1))
+		a.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(0))
+		a.WriteTo(c)
+	})
+	mux.HandleFunc("DWR", func(c diam.Conn, m *diam.Message) {
+		a := m.Answer(diam.Success)
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, serverSettings.OriginHost)
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, serverSettings.OriginRealm)
+		a.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(2))
+		a.WriteTo(c)
+	})
+	srv := diamtest.NewServer(mux, dict.Default)
+	defer srv.Close()
+	cli := &Client{
+		EnableWatchdog:   true,
+		WatchdogInterval: 50 * time.Millisecond,
+		Handler:          New(clientSettings),
+		AcctApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(0)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	select {
+	case er := <-cli.Handler.ErrorReports():
+		e, ok := er.Error.(*ErrPeerRestarted)
+		if !ok {
+			t.Fatal(er)
+		}
+		if e.Old != 1 || e.New != 2 {
+			t.Fatalf("Unexpected Origin-State-Id change: %s", e)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timeout waiting for peer restart report")
+	}
+	select {
+	case <-c.(diam.CloseNotifier).CloseNotify():
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timeout waiting for watchdog to disconnect client")
+	}
+}
+
+func TestClient_Watchdog_MatchedAnswer(t *testing.T) {
+	srv := diamtest.NewServer(New(serverSettings), dict.Default)
+	defer srv.Close()
+	cli := &Client{
+		RetransmitInterval: 50 * time.Millisecond,
+		EnableWatchdog:     true,
+		WatchdogInterval:   5 * time.Millisecond,
+		Handler:            New(clientSettings),
+		AcctApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(0)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	time.Sleep(100 * time.Millisecond)
+	if n := cli.Handler.UnmatchedAnswers(); n != 0 {
+		t.Fatalf("Unexpected # of unmatched answers. Want 0, have %d", n)
+	}
+}
+
+func TestClient_Watchdog_UnmatchedAnswer(t *testing.T) {
+	sm := New(serverSettings)
+	sm.mux.HandleFunc("DWR", func(c diam.Conn, m *diam.Message) {
+		a := m.Answer(diam.Success)
+		a.Header.HopByHopID++
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, serverSettings.OriginHost)
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, serverSettings.OriginRealm)
+		a.WriteTo(c)
+	})
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	unmatched := make(chan *diam.Message, 10)
+	settings := *clientSettings
+	settings.UnmatchedAnswer = func(c diam.Conn, m *diam.Message) {
+		unmatched <- m
+	}
+	cli := &Client{
+		RetransmitInterval: 50 * time.Millisecond,
+		EnableWatchdog:     true,
+		WatchdogInterval:   50 * time.Millisecond,
+		Handler:            New(&settings),
+		AcctApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(0)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	select {
+	case m := <-unmatched:
+		if m.Header.CommandCode != diam.DeviceWatchdog {
+			t.Fatalf("Unexpected unmatched answer:\n%s", m)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timeout waiting for unmatched answer")
+	}
+	if cli.Handler.UnmatchedAnswers() == 0 {
+		t.Fatal("Unmatched answer was not counted")
+	}
+	// The DWR was never answered, so the watchdog disconnects.
+	select {
+	case <-c.(diam.CloseNotifier).CloseNotify():
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Timeout waiting for watchdog to disconnect client")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"fmt"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
+)
+
+// ErrUnableToDeliver is returned by Deliver and DeliverOrReject when
+// the peer connected to a connection has not advertised the application
+// of a request, or has not passed the handshake yet.
+type ErrUnableToDeliver struct {
+	OriginHost    datatype.DiameterIdentity // Empty before the handshake
+	ApplicationID uint32
+}
+
+// Error implements the error interface.
+func (e *ErrUnableToDeliver) Error() string {
+	if e.OriginHost == "" {
+		return fmt.Sprintf("unable to deliver application %d: peer has not passed the handshake",
+			e.ApplicationID)
+	}
+	return fmt.Sprintf("unable to deliver application %d: not supported by peer %s",
+		e.ApplicationID, e.OriginHost)
+}
+
+// Deliver writes m to c only if the peer connected to c advertised
+// the application of m in its CER or CEA. Requests for other
+// applications fail fast with ErrUnableToDeliver, without being
+// written, so peers that support fewer applications than this node
+// are not sent requests they cannot handle.
+func Deliver(c diam.Conn, m *diam.Message) error {
+	meta, ok := smpeer.FromConn(c)
+	if !ok {
+		return &ErrUnableToDeliver{ApplicationID: m.Header.ApplicationID}
+	}
+	if !meta.Supports(m.Header.ApplicationID) {
+		return &ErrUnableToDeliver{
+			OriginHost:    meta.OriginHost,
+			ApplicationID: m.Header.ApplicationID,
+		}
+	}
+	_, err := m.WriteTo(c)
+	return err
+}
+
+// DeliverOrReject delivers the request m, received from the connection
+// from, to the connection to. When the peer connected to to does not
+// support the application of m, the request is answered on from with
+// DIAMETER_UNABLE_TO_DELIVER (3002) and ErrUnableToDeliver is returned.
+//
+// The request is written as is. It is not a relay agent as in RFC 6733
+// section 6.1.8: the Hop-by-Hop Identifier is not replaced, no
+// Route-Record is added, and answers are not sent back to from.
+func (sm *StateMachine) DeliverOrReject(m *diam.Message, from, to diam.Conn) error {
+	err := Deliver(to, m)
+	if _, ok := err.(*ErrUnableToDeliver); ok {
+		sm.writeResultCode(from, m, diam.UnableToDeliver)
+	}
+	return err
+}
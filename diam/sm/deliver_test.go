@@ -0,0 +1,56 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestDeliver(t *testing.T) {
+	sm := New(serverSettings)
+	sm.HandleFunc("CCR", func(c diam.Conn, m *diam.Message) {
+		m.Answer(diam.Success).WriteTo(c)
+	})
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	cli.Handler.HandleFunc("CCA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	// The server only echoes application 4 in its CEA.
+	err = Deliver(c, diam.NewRequest(diam.Accounting, 1001, dict.Default))
+	if e, ok := err.(*ErrUnableToDeliver); !ok || e.ApplicationID != 1001 {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err = Deliver(c, newCCR(ccInitialRequest)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-mc:
+		if !testResultCode(resp, diam.Success) {
+			t.Fatalf("Unexpected result code.\n%s", resp)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No CCA received")
+	}
+}
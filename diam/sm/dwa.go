@@ -5,14 +5,30 @@
 package sm
 
 import (
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
-)
+	"fmt"
 
-var dwaACK = struct{}{}
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
+)
 
 // handleDWA handles Device-Watchdog-Answer messages.
-func handleDWA(sm *StateMachine, dwac chan struct{}) diam.HandlerFunc {
+//
+// Answers with a Result-Code other than success are reported as
+// ErrFailedResultCode and not acknowledged, causing the watchdog to
+// retransmit and eventually disconnect from the peer. Answers with an
+// Origin-State-Id that differs from the one advertised in the
+// handshake indicate that the peer has restarted; they are reported
+// as ErrPeerRestarted and the connection is closed so the application
+// can fail over and purge state associated with the peer.
+//
+// Answers are queued for the watchdog, which discards the ones that do
+// not match its pending DWR. Answers received while the queue is full
+// are discarded here. See StateMachine.UnmatchedAnswers.
+//
+// See RFC 6733 sections 5.5.2 and 8.16 for details.
+func handleDWA(sm *StateMachine, dwac chan *diam.Message) diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		dwa := new(smparser.DWA)
 		if err := dwa.Parse(m); err != nil {
@@ -24,11 +40,47 @@ func handleDWA(sm *StateMachine, dwac chan struct{}) diam.HandlerFunc {
 			return
 		}
 		if dwa.ResultCode != diam.Success {
+			sm.Error(&diam.ErrorReport{
+				Conn:    c,
+				Message: m,
+				Error:   &ErrFailedResultCode{Code: dwa.ResultCode},
+			})
+			return
+		}
+		meta, ok := smpeer.FromContext(c.Context())
+		if ok && meta.OriginStateID != 0 && dwa.OriginStateID != 0 &&
+			uint32(meta.OriginStateID) != dwa.OriginStateID {
+			sm.Error(&diam.ErrorReport{
+				Conn:    c,
+				Message: m,
+				Error: &ErrPeerRestarted{
+					OriginHost: meta.OriginHost,
+					Old:        meta.OriginStateID,
+					New:        datatype.Unsigned32(dwa.OriginStateID),
+				},
+			})
+			c.Close()
 			return
 		}
 		select {
-		case dwac <- dwaACK:
+		case dwac <- m:
 		default:
+			sm.unmatchedAnswer(c, m)
 		}
 	}
 }
+
+// ErrPeerRestarted is reported by the client watchdog when a DWA carries
+// an Origin-State-Id different from the one received in the handshake,
+// which indicates the peer has restarted and lost its state.
+type ErrPeerRestarted struct {
+	OriginHost datatype.DiameterIdentity
+	Old        datatype.Unsigned32
+	New        datatype.Unsigned32
+}
+
+// Error implements the error interface.
+func (e *ErrPeerRestarted) Error() string {
+	return fmt.Sprintf("peer %s restarted: Origin-State-Id changed from %d to %d",
+		e.OriginHost, e.Old, e.New)
+}
@@ -5,12 +5,9 @@
 package sm
 
 import (
-	"time"
-
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
 )
 
 // handleDWR handles Device-Watchdog-Request messages.
@@ -31,11 +28,11 @@ func handleDWR(sm *StateMachine) diam.HandlerFunc {
 			})
 			return
 		}
-		a := m.Answer(diam.Success)
-		a.NewAVP(avp.OriginHost, avp.Mbit, 0, sm.cfg.OriginHost)
-		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, sm.cfg.OriginRealm)
-		stateid := datatype.Unsigned32(time.Now().Unix())
-		a.NewAVP(avp.OriginStateID, avp.Mbit, 0, stateid)
+		a := m.AnswerWith(diam.Success, &diam.AnswerOptions{
+			OriginHost:  sm.cfg.OriginHost,
+			OriginRealm: sm.cfg.OriginRealm,
+		})
+		a.NewAVP(avp.OriginStateID, avp.Mbit, 0, sm.OriginStateID())
 		_, err = a.WriteTo(c)
 		if err != nil {
 			sm.Error(&diam.ErrorReport{
@@ -0,0 +1,79 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"errors"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+)
+
+// HandlerE is implemented by handlers that return the answer to a
+// request instead of writing it. Handlers return a *diam.ResultError
+// to have the request answered with that Result-Code. HandlerE is
+// registered on a StateMachine, which provides the Origin-Host and
+// Origin-Realm of such answers; see StateMachine.HandleE.
+type HandlerE interface {
+	ServeDIAME(diam.Conn, *diam.Message) (*diam.Message, error)
+}
+
+// The HandlerFuncE type is an adapter to allow the use of ordinary
+// functions as HandlerE.
+type HandlerFuncE func(diam.Conn, *diam.Message) (*diam.Message, error)
+
+// ServeDIAME calls f(c, m).
+func (f HandlerFuncE) ServeDIAME(c diam.Conn, m *diam.Message) (*diam.Message, error) {
+	return f(c, m)
+}
+
+// HandleE registers a handler that returns the answer to the given
+// command instead of writing it. A non-nil answer is written to the
+// connection. When the handler returns a *diam.ResultError, or an
+// error that wraps one, the request is answered with its Result-Code,
+// Error-Message and Failed-AVP. Other errors are reported to
+// ErrorReports, and the request is answered with
+// DIAMETER_UNABLE_TO_COMPLY (5012).
+func (sm *StateMachine) HandleE(cmd string, handler HandlerE) {
+	sm.HandleFunc(cmd, handleE(sm, handler))
+}
+
+// HandleFuncE registers the handler function for the given command.
+// See HandleE.
+func (sm *StateMachine) HandleFuncE(cmd string, handler HandlerFuncE) {
+	sm.HandleE(cmd, handler)
+}
+
+// handleE calls h and writes the answer it returns, or the answer to
+// its error.
+func handleE(sm *StateMachine, h HandlerE) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		a, err := h.ServeDIAME(c, m)
+		if err != nil {
+			var re *diam.ResultError
+			if !errors.As(err, &re) {
+				sm.Error(&diam.ErrorReport{
+					Conn:    c,
+					Message: m,
+					Error:   err,
+				})
+				re = &diam.ResultError{Code: diam.UnableToComply}
+			}
+			if m.Header.CommandFlags&diam.RequestFlag != 0 {
+				sm.writeResultError(c, m, re)
+			}
+			return
+		}
+		if a == nil {
+			return
+		}
+		if _, err = a.WriteTo(c); err != nil {
+			sm.Error(&diam.ErrorReport{
+				Conn:    c,
+				Message: m,
+				Error:   err,
+			})
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestStateMachine_HandleE(t *testing.T) {
+	sm := New(serverSettings)
+	failed := diam.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(ccInitialRequest))
+	var n int
+	sm.HandleFuncE("CCR", func(c diam.Conn, m *diam.Message) (*diam.Message, error) {
+		n++
+		switch n {
+		case 1:
+			return m.Answer(diam.Success), nil
+		case 2:
+			return nil, &diam.ResultError{
+				Code:      diam.InvalidAVPValue,
+				Message:   "no initial requests",
+				FailedAVP: []*diam.AVP{failed},
+			}
+		case 3:
+			return nil, fmt.Errorf("session lookup: %w",
+				&diam.ResultError{Code: diam.UnknownSessionID})
+		}
+		return nil, errors.New("database unavailable")
+	})
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	cli.Handler.HandleFunc("CCA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	for _, code := range []uint32{diam.Success, diam.InvalidAVPValue, diam.UnknownSessionID, diam.UnableToComply} {
+		if _, err = newCCR(ccInitialRequest).WriteTo(c); err != nil {
+			t.Fatal(err)
+		}
+		var resp *diam.Message
+		select {
+		case resp = <-mc:
+		case <-time.After(time.Second):
+			t.Fatal("No CCA received")
+		}
+		if !testResultCode(resp, code) {
+			t.Fatalf("Unexpected result code. Want %d.\n%s", code, resp)
+		}
+		if code != diam.InvalidAVPValue {
+			continue
+		}
+		if _, err := resp.FindAVP(avp.SessionID); err != nil {
+			t.Fatalf("Missing Session-Id in answer.\n%s", resp)
+		}
+		if a, err := resp.FindAVP(avp.ErrorMessage); err != nil {
+			t.Fatalf("Missing Error-Message in answer.\n%s", resp)
+		} else if v := a.Data.(datatype.UTF8String); v != "no initial requests" {
+			t.Fatalf("Unexpected Error-Message. Want \"no initial requests\", have %q", v)
+		}
+		a, err := resp.FindAVP(avp.FailedAVP)
+		if err != nil {
+			t.Fatalf("Missing Failed-AVP in answer.\n%s", resp)
+		}
+		if g := a.Data.(*diam.GroupedAVP); len(g.AVP) != 1 || g.AVP[0].Code != avp.CCRequestType {
+			t.Fatalf("Unexpected Failed-AVP.\n%s", resp)
+		}
+	}
+	select {
+	case err := <-sm.ErrorReports():
+		if err.Error.Error() != "database unavailable" {
+			t.Fatalf("Unexpected error: %v", err.Error)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No error reported")
+	}
+}
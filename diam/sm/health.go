@@ -0,0 +1,119 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// ErrHealthCheckTimeout is returned by ProbeHealth when the peer
+// does not answer the health check request in time.
+var ErrHealthCheckTimeout = errors.New("health check timeout (no response)")
+
+// HandleHealthCheck registers a handler that automatically answers the
+// given health check request, for example "ECR" for a vendor specific
+// Echo command defined in the dictionary.
+//
+// The answer carries the status of this node: Result-Code, Origin-Host,
+// Origin-Realm, Product-Name and Firmware-Revision. The Session-Id and
+// Proxy-Info AVPs of the request, if any, are copied to the answer. Like
+// other handlers in the state machine, it only answers peers that passed
+// the handshake.
+func (sm *StateMachine) HandleHealthCheck(cmd string) {
+	sm.HandleFunc(cmd, handleHealthCheck(sm))
+}
+
+// handleHealthCheck answers health check requests.
+func handleHealthCheck(sm *StateMachine) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		a := m.AnswerWith(diam.Success, &diam.AnswerOptions{
+			SessionID:   true,
+			ProxyInfo:   true,
+			OriginHost:  sm.cfg.OriginHost,
+			OriginRealm: sm.cfg.OriginRealm,
+		})
+		a.NewAVP(avp.ProductName, 0, 0, sm.cfg.ProductName)
+		a.NewAVP(avp.FirmwareRevision, avp.Mbit, 0, sm.cfg.FirmwareRevision)
+		if _, err := a.WriteTo(c); err != nil {
+			sm.Error(&diam.ErrorReport{
+				Conn:    c,
+				Message: m,
+				Error:   err,
+			})
+		}
+	}
+}
+
+// probeAnswer delivers the answer m to the pending probe with the same
+// Hop-by-Hop Identifier, and reports whether there was one. Other
+// answers are left to the handlers of the state machine.
+func (sm *StateMachine) probeAnswer(m *diam.Message) bool {
+	if m.Header.CommandFlags&diam.RequestFlag != 0 {
+		return false
+	}
+	hbh := m.Header.HopByHopID
+	sm.pmu.Lock()
+	ac, ok := sm.probes[hbh]
+	delete(sm.probes, hbh)
+	sm.pmu.Unlock()
+	if ok {
+		ac <- m
+	}
+	return ok
+}
+
+// ProbeHealth sends the health check request cmd of application appid to
+// the peer connected to c, and waits up to timeout for the answer. The
+// command must be defined in the dictionary of the connection.
+//
+// ProbeHealth returns nil if the peer answers with DIAMETER_SUCCESS,
+// ErrFailedResultCode if it answers with any other Result-Code, and
+// ErrHealthCheckTimeout if no answer is received. The answer is taken
+// by the state machine before its handlers, so a handler registered for
+// it only receives other answers.
+func (cli *Client) ProbeHealth(c diam.Conn, appid, cmd uint32, timeout time.Duration) error {
+	if cli.Handler == nil {
+		return ErrMissingStateMachine
+	}
+	sm := cli.Handler
+	_, err := c.Dictionary().FindCommand(appid, cmd)
+	if err != nil {
+		return err
+	}
+	m := diam.NewRequest(cmd, appid, c.Dictionary())
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, sm.cfg.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, sm.cfg.OriginRealm)
+	ac := make(chan *diam.Message, 1)
+	hbh := m.Header.HopByHopID
+	sm.pmu.Lock()
+	sm.probes[hbh] = ac
+	sm.pmu.Unlock()
+	defer func() {
+		sm.pmu.Lock()
+		delete(sm.probes, hbh)
+		sm.pmu.Unlock()
+	}()
+	if _, err = m.WriteTo(c); err != nil {
+		return err
+	}
+	select {
+	case a := <-ac:
+		rc, err := a.FindAVP(avp.ResultCode)
+		if err != nil {
+			return err
+		}
+		if code, ok := rc.Data.(datatype.Unsigned32); !ok || code != diam.Success {
+			return &ErrFailedResultCode{Code: uint32(code)}
+		}
+		return nil
+	case <-time.After(timeout):
+		return ErrHealthCheckTimeout
+	}
+}
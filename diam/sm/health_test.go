@@ -0,0 +1,141 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+var healthDictionary = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="999" type="auth">
+		<command code="900" short="EC" name="Echo">
+			<request>
+				<rule avp="Origin-Host" required="true" max="1"/>
+				<rule avp="Origin-Realm" required="true" max="1"/>
+			</request>
+			<answer>
+				<rule avp="Result-Code" required="true" max="1"/>
+				<rule avp="Origin-Host" required="true" max="1"/>
+				<rule avp="Origin-Realm" required="true" max="1"/>
+				<rule avp="Product-Name" required="false" max="1"/>
+				<rule avp="Firmware-Revision" required="false" max="1"/>
+			</answer>
+		</command>
+	</application>
+</diameter>
+`
+
+func newHealthDict(t *testing.T) *dict.Parser {
+	dp, err := dict.NewParser("../dict/xml/base.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = dp.Load(bytes.NewReader([]byte(healthDictionary))); err != nil {
+		t.Fatal(err)
+	}
+	return dp
+}
+
+func TestHealthCheck(t *testing.T) {
+	dp := newHealthDict(t)
+	sm := New(serverSettings)
+	sm.HandleHealthCheck("ECR")
+	srv := diamtest.NewServer(sm, dp)
+	defer srv.Close()
+	cli := &Client{
+		Dict:    dp,
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(999)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = cli.ProbeHealth(c, 999, 900, time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHealthCheck_Timeout(t *testing.T) {
+	dp := newHealthDict(t)
+	srv := diamtest.NewServer(New(serverSettings), dp)
+	defer srv.Close()
+	cli := &Client{
+		Dict:    dp,
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(999)),
+		},
+	}
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	err = cli.ProbeHealth(c, 999, 900, 100*time.Millisecond)
+	if err != ErrHealthCheckTimeout {
+		t.Fatalf("Unexpected error. Want %v, have %v", ErrHealthCheckTimeout, err)
+	}
+}
+
+func TestHealthCheck_AnswerHandler(t *testing.T) {
+	dp := newHealthDict(t)
+	sm := New(serverSettings)
+	sm.HandleHealthCheck("ECR")
+	srv := diamtest.NewServer(sm, dp)
+	defer srv.Close()
+	eca := make(chan *diam.Message, 1)
+	cli := &Client{
+		Dict:    dp,
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(999)),
+		},
+	}
+	cli.Handler.HandleFunc("ECA", func(c diam.Conn, m *diam.Message) {
+		eca <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = cli.ProbeHealth(c, 999, 900, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-eca:
+		t.Fatal("Probe answer delivered to the ECA handler")
+	default:
+	}
+	// The ECA handler is still registered after the probe.
+	m := diam.NewRequest(900, 999, dp)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientSettings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+	if _, err = m.WriteTo(c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case a := <-eca:
+		if a.Header.HopByHopID != m.Header.HopByHopID {
+			t.Fatalf("Unexpected Hop-by-Hop Identifier. Want %d, have %d",
+				m.Header.HopByHopID, a.Header.HopByHopID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out: no ECA received")
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"sync/atomic"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// Values of the CC-Request-Type and Accounting-Record-Type AVPs.
+// See RFC 4006 section 8.3 and RFC 6733 section 9.8.1.
+const (
+	ccInitialRequest = 1
+	ccEventRequest   = 4
+	acctEventRecord  = 1
+	acctStartRecord  = 2
+)
+
+// SetMaintenance enables or disables maintenance mode in the state
+// machine. It is safe to call SetMaintenance from any goroutine, for
+// example from an administrative API.
+//
+// While in maintenance mode, requests that initiate new sessions are
+// answered with Settings.MaintenanceResultCode instead of being passed
+// to their handlers. Requests of sessions in progress, and requests
+// that are not part of a session such as DWR, are handled as usual.
+// See Settings.SessionInitiating for details.
+func (sm *StateMachine) SetMaintenance(enable bool) {
+	var v int32
+	if enable {
+		v = 1
+	}
+	atomic.StoreInt32(&sm.maintenance, v)
+}
+
+// Maintenance reports whether the state machine is in maintenance mode.
+func (sm *StateMachine) Maintenance() bool {
+	return atomic.LoadInt32(&sm.maintenance) == 1
+}
+
+// maintenanceOK is a wrapper for handlers that answers session
+// initiating requests when the state machine is in maintenance mode.
+func maintenanceOK(sm *StateMachine, h diam.HandlerFunc) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		if !sm.Maintenance() || !sm.sessionInitiating(m) {
+			h(c, m)
+			return
+		}
+		code := sm.cfg.MaintenanceResultCode
+		if code == 0 {
+			code = diam.TooBusy
+		}
+		sm.writeResultCode(c, m, code)
+	}
+}
+
+// sessionInitiating reports whether m is a request that initiates a new
+// session, using Settings.SessionInitiating when set.
+func (sm *StateMachine) sessionInitiating(m *diam.Message) bool {
+	if m.Header.CommandFlags&diam.RequestFlag == 0 {
+		return false
+	}
+	if sm.cfg.SessionInitiating != nil {
+		return sm.cfg.SessionInitiating(m)
+	}
+	return SessionInitiating(m)
+}
+
+// SessionInitiating is the default function used by the state machine
+// in maintenance mode to classify requests. It reports whether m is a
+// request that carries a Session-Id and initiates a new session:
+// credit control initial and event requests, accounting start and
+// event records, and any other request that is not a Re-Auth, Abort
+// or Session-Termination of an existing session.
+func SessionInitiating(m *diam.Message) bool {
+	if m.Header.CommandFlags&diam.RequestFlag == 0 {
+		return false
+	}
+	if findAVP(m, avp.SessionID) == nil {
+		return false
+	}
+	switch m.Header.CommandCode {
+	case diam.ReAuth, diam.AbortSession, diam.SessionTermination:
+		return false
+	}
+	if a := findAVP(m, avp.CCRequestType); a != nil {
+		v, _ := a.Data.(datatype.Enumerated)
+		return v == ccInitialRequest || v == ccEventRequest
+	}
+	if a := findAVP(m, avp.AccountingRecordType); a != nil {
+		v, _ := a.Data.(datatype.Enumerated)
+		return v == acctStartRecord || v == acctEventRecord
+	}
+	return true
+}
+
+// findAVP returns the first AVP in m with the given code, or nil.
+// Unlike diam.Message.FindAVP it does not need the AVP to be in the
+// dictionary of the message's application.
+func findAVP(m *diam.Message, code uint32) *diam.AVP {
+	for _, a := range m.AVP {
+		if a.Code == code {
+			return a
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func newCCR(requestType int32) *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, 4, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("cli;1;1"))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientSettings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, serverSettings.OriginRealm)
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(requestType))
+	m.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned32(0))
+	return m
+}
+
+func TestSessionInitiating(t *testing.T) {
+	for requestType, want := range map[int32]bool{
+		ccInitialRequest: true,
+		2:                false,
+		3:                false,
+		ccEventRequest:   true,
+	} {
+		if v := SessionInitiating(newCCR(requestType)); v != want {
+			t.Fatalf("Unexpected result for CC-Request-Type %d. Want %t, have %t",
+				requestType, want, v)
+		}
+	}
+	dwr := diam.NewRequest(diam.DeviceWatchdog, 0, dict.Default)
+	if SessionInitiating(dwr) {
+		t.Fatal("DWR is not session initiating")
+	}
+}
+
+func TestStateMachine_Maintenance(t *testing.T) {
+	sm := New(serverSettings)
+	sm.HandleFunc("CCR", func(c diam.Conn, m *diam.Message) {
+		m.Answer(diam.Success).WriteTo(c)
+	})
+	sm.SetMaintenance(true)
+	if !sm.Maintenance() {
+		t.Fatal("Maintenance mode was not enabled")
+	}
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	cli.Handler.HandleFunc("CCA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	for _, test := range []struct {
+		RequestType int32
+		ResultCode  uint32
+	}{
+		{ccInitialRequest, diam.TooBusy},
+		{2, diam.Success},
+	} {
+		if _, err = newCCR(test.RequestType).WriteTo(c); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case resp := <-mc:
+			if !testResultCode(resp, test.ResultCode) {
+				t.Fatalf("Unexpected result code for CC-Request-Type %d.\n%s",
+					test.RequestType, resp)
+			}
+		case err := <-sm.ErrorReports():
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("No CCA received")
+		}
+	}
+}
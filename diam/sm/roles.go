@@ -0,0 +1,197 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
+)
+
+// Role is the role of this node in an application: client, sending
+// requests, server, answering them, or both. See
+// Settings.ApplicationRoles.
+type Role uint8
+
+// Roles of this node in an application.
+const (
+	NoRole     Role = 0 // Application is not supported
+	ClientRole Role = 1 // Sends requests
+	ServerRole Role = 2 // Answers requests
+	BothRoles       = ClientRole | ServerRole
+)
+
+// String returns the name of the role.
+func (r Role) String() string {
+	switch r {
+	case ClientRole:
+		return "client"
+	case ServerRole:
+		return "server"
+	case BothRoles:
+		return "client and server"
+	case NoRole:
+		return "none"
+	}
+	return fmt.Sprintf("Role(%d)", uint8(r))
+}
+
+// role returns the role of this node in the application appid. The
+// base protocol and applications not listed in ApplicationRoles have
+// both roles.
+func (sm *StateMachine) role(appid uint32) Role {
+	if appid == 0 {
+		return BothRoles
+	}
+	r, ok := sm.cfg.ApplicationRoles[appid]
+	if !ok {
+		return BothRoles
+	}
+	return r
+}
+
+// ErrApplicationRole is reported by the state machine when a peer
+// sends a request of an application in which this node is not a
+// server.
+type ErrApplicationRole struct {
+	OriginHost    datatype.DiameterIdentity
+	ApplicationID uint32
+	Role          Role
+}
+
+// Error implements the error interface.
+func (e *ErrApplicationRole) Error() string {
+	return fmt.Sprintf("peer %s sent a request of application %d, in which we are %s",
+		e.OriginHost, e.ApplicationID, e.Role)
+}
+
+// RoleViolations returns the number of requests rejected by the state
+// machine because this node is not a server of their application.
+func (sm *StateMachine) RoleViolations() uint64 {
+	return atomic.LoadUint64(&sm.roleViolations)
+}
+
+// serves enforces the ApplicationRoles of this node. Requests of
+// applications in which this node is not a server are answered with
+// DIAMETER_APPLICATION_UNSUPPORTED, counted, and reported as
+// ErrApplicationRole.
+func (sm *StateMachine) serves(c diam.Conn, m *diam.Message) bool {
+	if len(sm.cfg.ApplicationRoles) == 0 || m.Header.CommandFlags&diam.RequestFlag == 0 {
+		return true
+	}
+	r := sm.role(m.Header.ApplicationID)
+	if r&ServerRole != 0 {
+		return true
+	}
+	err := &ErrApplicationRole{ApplicationID: m.Header.ApplicationID, Role: r}
+	if host, e := m.FindAVP(avp.OriginHost); e == nil {
+		err.OriginHost, _ = host.Data.(datatype.DiameterIdentity)
+	}
+	atomic.AddUint64(&sm.roleViolations, 1)
+	sm.Error(&diam.ErrorReport{
+		Conn:    c,
+		Message: m,
+		Error:   err,
+	})
+	sm.writeResultCode(c, m, diam.ApplicationUnsupported)
+	return false
+}
+
+// cerApplication is an application advertised in a CER.
+type cerApplication struct {
+	id  uint32
+	typ string    // "acct" or "auth"
+	avp *diam.AVP // Top level AVP of the application
+}
+
+// cerApplications returns the applications advertised in the CER, in
+// Acct-Application-Id, Auth-Application-Id or inside
+// Vendor-Specific-Application-Id AVPs.
+func cerApplications(cer *smparser.CER) []cerApplication {
+	var apps []cerApplication
+	add := func(top, a *diam.AVP) {
+		id, ok := a.Data.(datatype.Unsigned32)
+		if !ok {
+			return
+		}
+		switch a.Code {
+		case avp.AcctApplicationID:
+			apps = append(apps, cerApplication{uint32(id), "acct", top})
+		case avp.AuthApplicationID:
+			apps = append(apps, cerApplication{uint32(id), "auth", top})
+		}
+	}
+	for _, a := range cer.AcctApplicationID {
+		add(a, a)
+	}
+	for _, a := range cer.AuthApplicationID {
+		add(a, a)
+	}
+	for _, vs := range cer.VendorSpecificApplicationID {
+		if g, ok := vs.Data.(*diam.GroupedAVP); ok {
+			for _, a := range g.AVP {
+				add(vs, a)
+			}
+		}
+	}
+	return apps
+}
+
+// checkRoles returns an error when all the applications advertised in
+// the CER have NoRole in ApplicationRoles, with the AVP of the first
+// one as the failed AVP.
+func (sm *StateMachine) checkRoles(cer *smparser.CER) (failedAVP *diam.AVP, err error) {
+	apps := cerApplications(cer)
+	for _, app := range apps {
+		if sm.role(app.id) != NoRole {
+			return nil, nil
+		}
+	}
+	if len(apps) == 0 {
+		return nil, nil
+	}
+	return apps[0].avp, &smparser.ErrNoCommonApplication{ID: apps[0].id, Type: apps[0].typ}
+}
+
+// advertises reports whether the application AVP a of a CER, an
+// Auth-Application-Id, Acct-Application-Id or Vendor-Specific-Application-Id,
+// is for an application in which this node has a role.
+func (sm *StateMachine) advertises(a *diam.AVP) bool {
+	if g, ok := a.Data.(*diam.GroupedAVP); ok {
+		for _, ga := range g.AVP {
+			if ga.Code != avp.AuthApplicationID && ga.Code != avp.AcctApplicationID {
+				continue
+			}
+			if sm.advertises(ga) {
+				return true
+			}
+		}
+		return false
+	}
+	id, ok := a.Data.(datatype.Unsigned32)
+	return !ok || sm.role(uint32(id)) != NoRole
+}
+
+// commonApplications returns the application AVPs of the CER for the
+// applications this node supports, in any role, to be advertised in
+// the CEA.
+func (sm *StateMachine) commonApplications(cer *smparser.CER) []*diam.AVP {
+	var avps []*diam.AVP
+	for _, app := range cerApplications(cer) {
+		if sm.role(app.id) == NoRole {
+			continue
+		}
+		if n := len(avps); n > 0 && avps[n-1] == app.avp {
+			// Vendor-Specific-Application-Id with acct and auth IDs.
+			continue
+		}
+		avps = append(avps, app.avp)
+	}
+	return avps
+}
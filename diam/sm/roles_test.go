@@ -0,0 +1,153 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
+)
+
+func TestStateMachine_ApplicationRoles(t *testing.T) {
+	settings := *serverSettings
+	settings.ApplicationRoles = map[uint32]Role{4: ClientRole}
+	sm := New(&settings)
+	sm.HandleFunc("CCR", func(c diam.Conn, m *diam.Message) {
+		m.Answer(diam.Success).WriteTo(c)
+	})
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	cli.Handler.HandleFunc("CCA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if _, err = newCCR(ccInitialRequest).WriteTo(c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-mc:
+		if !testResultCode(resp, diam.ApplicationUnsupported) {
+			t.Fatalf("Unexpected result code.\n%s", resp)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No CCA received")
+	}
+	select {
+	case err := <-sm.ErrorReports():
+		e, ok := err.Error.(*ErrApplicationRole)
+		if !ok || e.ApplicationID != 4 || e.Role != ClientRole {
+			t.Fatalf("Unexpected error: %v", err.Error)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No error reported")
+	}
+	if n := sm.RoleViolations(); n != 1 {
+		t.Fatalf("Unexpected # of role violations. Want 1, have %d", n)
+	}
+}
+
+func TestStateMachine_ApplicationNoRole(t *testing.T) {
+	settings := *serverSettings
+	settings.ApplicationRoles = map[uint32]Role{4: NoRole}
+	srv := diamtest.NewServer(New(&settings), dict.Default)
+	defer srv.Close()
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	if c, err := cli.Dial(srv.Address); err == nil {
+		c.Close()
+		t.Fatal("Handshake succeeded for application with no role")
+	}
+}
+
+func TestClient_CERApplicationRoles(t *testing.T) {
+	settings := *clientSettings
+	settings.ApplicationRoles = map[uint32]Role{
+		3:        NoRole,
+		4:        ClientRole,
+		16777251: NoRole,
+	}
+	cli := &Client{
+		Dict:    dict.Default,
+		Handler: New(&settings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+		AcctApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(3)),
+		},
+		VendorSpecificApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(10415)),
+					diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(16777251)),
+				},
+			}),
+		},
+	}
+	m := cli.makeCER(net.ParseIP("127.0.0.1"))
+	if _, err := m.FindAVP(avp.AuthApplicationID); err != nil {
+		t.Fatalf("Application with a role not advertised: %s", m)
+	}
+	for _, code := range []uint32{avp.AcctApplicationID, avp.VendorSpecificApplicationID} {
+		if _, err := m.FindAVP(code); err == nil {
+			t.Fatalf("Application with no role advertised: %s", m)
+		}
+	}
+}
+
+func TestCommonApplications(t *testing.T) {
+	sm := New(&Settings{ApplicationRoles: map[uint32]Role{
+		3: NoRole,
+		4: ServerRole,
+	}})
+	acct := diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(3))
+	auth := diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4))
+	vsa := diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(10415)),
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(16777251)),
+			diam.NewAVP(avp.AcctApplicationID, avp.Mbit, 0, datatype.Unsigned32(16777251)),
+		},
+	})
+	cer := &smparser.CER{
+		AcctApplicationID:           []*diam.AVP{acct},
+		AuthApplicationID:           []*diam.AVP{auth},
+		VendorSpecificApplicationID: []*diam.AVP{vsa},
+	}
+	avps := sm.commonApplications(cer)
+	if len(avps) != 2 || avps[0] != auth || avps[1] != vsa {
+		t.Fatalf("Unexpected applications: %v", avps)
+	}
+	if _, err := sm.checkRoles(cer); err != nil {
+		t.Fatal(err)
+	}
+	cer.AuthApplicationID, cer.VendorSpecificApplicationID = nil, nil
+	failed, err := sm.checkRoles(cer)
+	if err == nil || failed != acct {
+		t.Fatalf("Unexpected result. Want %s, have %v (%v)", acct, failed, err)
+	}
+}
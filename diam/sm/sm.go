@@ -6,10 +6,14 @@ package sm
 
 import (
 	"fmt"
-
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/sm/smpeer"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smaudit"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smpeer"
 )
 
 // Settings used to configure the state machine.
@@ -19,6 +23,71 @@ type Settings struct {
 	VendorID         datatype.Unsigned32
 	ProductName      datatype.UTF8String
 	FirmwareRevision datatype.Unsigned32
+
+	// OriginStateID is the Origin-State-Id of this node. When unset,
+	// a value generated once at process start up is used.
+	// See LoadOriginStateID for persisting it across restarts.
+	OriginStateID datatype.Unsigned32
+
+	// PeerDict maps a peer's Origin-Host to the dictionary used to
+	// encode and decode messages of that peer, after the CER/CEA
+	// handshake. Peers not listed keep the connection's dictionary.
+	PeerDict map[datatype.DiameterIdentity]*dict.Parser
+
+	// MaintenanceResultCode is the Result-Code used to answer session
+	// initiating requests in maintenance mode. Defaults to
+	// DIAMETER_TOO_BUSY (3004). See StateMachine.SetMaintenance.
+	MaintenanceResultCode uint32
+
+	// SessionInitiating reports whether a request initiates a new
+	// session, and is used in maintenance mode. Defaults to the
+	// SessionInitiating function.
+	SessionInitiating func(m *diam.Message) bool
+
+	// UnmatchedAnswer is called for DWAs received by the state machine
+	// whose Hop-by-Hop Identifier does not match a pending DWR sent by
+	// the client watchdog or a health probe, such as late DWAs. These
+	// answers are discarded as required by RFC 6733 section 6.2, and
+	// counted. Answers to other commands are left to their handlers.
+	// See StateMachine.UnmatchedAnswers.
+	UnmatchedAnswer diam.HandlerFunc
+
+	// StaticAnswers maps command names such as "CCR" to the Result-Code
+	// used to answer them, without writing handlers for them. They are
+	// registered by New; see StateMachine.HandleStaticAnswer.
+	StaticAnswers map[string]uint32
+
+	// PeerACL maps a peer's Origin-Host to the list of requests the
+	// peer is allowed to send, enforced after the CER/CEA handshake
+	// and before dispatching requests to handlers. Requests denied
+	// are answered with DIAMETER_COMMAND_UNSUPPORTED (3001).
+	// See StateMachine.ACLViolations.
+	PeerACL map[datatype.DiameterIdentity]*CommandACL
+
+	// AllowRenegotiation enables accepting a CER from peers that
+	// have already passed the handshake, to renegotiate their
+	// capabilities on the open connection. When false, such CERs
+	// are ignored as retransmissions.
+	AllowRenegotiation bool
+
+	// ApplicationRoles maps application IDs to the role of this node
+	// in them. Requests of applications in which this node is not a
+	// server are answered with DIAMETER_APPLICATION_UNSUPPORTED (3007),
+	// and applications with NoRole are left out of the CEA, and of the
+	// CER sent by Client, as if not in the dictionary. Applications not
+	// listed have BothRoles. Roles are per application ID, and apply to
+	// both its Auth-Application-Id and Acct-Application-Id.
+	// See StateMachine.RoleViolations.
+	ApplicationRoles map[uint32]Role
+
+	// Blacklist, when set, records failed CER/CEA handshakes, and is
+	// reset when a peer passes the handshake. Share it with the
+	// diam.Server to refuse connections from the peers it blocks.
+	Blacklist *diam.Blacklist
+
+	// AuditLog records every capabilities exchange handled by the
+	// state machine, successful or not, in a tamper-evident log.
+	AuditLog *smaudit.Log
 }
 
 // StateMachine is a specialized type of diam.ServeMux that handles
@@ -27,9 +96,21 @@ type Settings struct {
 // Other handlers registered in the state machine are only executed
 // after the peer has passed the initial CER/CEA handshake.
 type StateMachine struct {
+	// Accessed atomically. 64-bit fields first for alignment.
+	unmatched      uint64 // # of unmatched DWAs
+	aclViolations  uint64 // # of requests denied by PeerACL
+	roleViolations uint64 // # of requests denied by ApplicationRoles
+	maintenance    int32  // 1 when in maintenance mode
+
 	cfg       *Settings
 	mux       *diam.ServeMux
 	hsNotifyc chan diam.Conn // handshake notifier
+
+	pmu    sync.Mutex                    // guards probes
+	probes map[uint32]chan *diam.Message // health probes by hop-by-hop id
+
+	cmu  sync.RWMutex                                // guards caps
+	caps map[datatype.DiameterIdentity]*Capabilities // peer capabilities by origin host
 }
 
 // New creates and initializes a new StateMachine for clients or servers.
@@ -38,9 +119,14 @@ func New(settings *Settings) *StateMachine {
 		cfg:       settings,
 		mux:       diam.NewServeMux(),
 		hsNotifyc: make(chan diam.Conn),
+		probes:    make(map[uint32]chan *diam.Message),
+		caps:      make(map[datatype.DiameterIdentity]*Capabilities),
 	}
 	sm.mux.Handle("CER", handleCER(sm))
 	sm.mux.Handle("DWR", handshakeOK(handleDWR(sm)))
+	for cmd, code := range settings.StaticAnswers {
+		sm.HandleStaticAnswer(cmd, code)
+	}
 	return sm
 }
 
@@ -51,6 +137,9 @@ func (sm *StateMachine) Settings() *Settings {
 
 // ServeDIAM implements the diam.Handler interface.
 func (sm *StateMachine) ServeDIAM(c diam.Conn, m *diam.Message) {
+	if !sm.allowed(c, m) || !sm.serves(c, m) || sm.probeAnswer(m) {
+		return
+	}
 	sm.mux.ServeDIAM(c, m)
 }
 
@@ -67,12 +156,30 @@ func (sm *StateMachine) HandleFunc(cmd string, handler diam.HandlerFunc) {
 			Error: fmt.Errorf("cannot overwrite %s command in the state machine", cmd),
 		})
 	default:
-		sm.mux.Handle(cmd, handshakeOK(handler))
+		sm.mux.Handle(cmd, handshakeOK(maintenanceOK(sm, handler)))
 	}
 }
 
+// HandleRealm registers the default handler for requests to the given
+// Destination-Realm. See diam.ServeMux.HandleRealm. Like other
+// handlers, it is only called for peers that completed the handshake.
+func (sm *StateMachine) HandleRealm(realm string, handler diam.Handler) {
+	sm.mux.HandleRealm(realm, handshakeOK(maintenanceOK(sm, handler.ServeDIAM)))
+}
+
 // Error implements the diam.ErrorReporter interface.
+//
+// Requests with AVPs that failed to decode are answered with the
+// Result-Code of the error, and the offending AVP in Failed-AVP.
 func (sm *StateMachine) Error(err *diam.ErrorReport) {
+	if e, ok := err.Error.(*diam.ErrDecodeAVP); ok && err.Conn != nil &&
+		err.Message != nil && err.Message.Header.CommandFlags&diam.RequestFlag != 0 {
+		re := &diam.ResultError{Code: e.ResultCode(), Message: e.Error()}
+		if a := e.FailedAVP(); a != nil {
+			re.FailedAVP = []*diam.AVP{a}
+		}
+		sm.writeResultError(err.Conn, err.Message, re)
+	}
 	sm.mux.Error(err)
 }
 
@@ -86,6 +193,33 @@ func (sm *StateMachine) HandshakeNotify() <-chan diam.Conn {
 	return sm.hsNotifyc
 }
 
+// UnmatchedAnswers returns the number of DWAs received by the state
+// machine that did not match a pending DWR and were discarded.
+func (sm *StateMachine) UnmatchedAnswers() uint64 {
+	return atomic.LoadUint64(&sm.unmatched)
+}
+
+// unmatchedAnswer discards the DWA m, which does not match a pending
+// DWR, and calls the UnmatchedAnswer handler if set.
+func (sm *StateMachine) unmatchedAnswer(c diam.Conn, m *diam.Message) {
+	atomic.AddUint64(&sm.unmatched, 1)
+	if sm.cfg.UnmatchedAnswer != nil {
+		sm.cfg.UnmatchedAnswer(c, m)
+	}
+}
+
+// setPeerDictionary replaces the dictionary of connection c with the
+// one configured for the peer identified by host, if any.
+func (sm *StateMachine) setPeerDictionary(c diam.Conn, host datatype.DiameterIdentity) {
+	dp, ok := sm.cfg.PeerDict[host]
+	if !ok || dp == nil {
+		return
+	}
+	if ds, ok := c.(diam.DictionarySetter); ok {
+		ds.SetDictionary(dp)
+	}
+}
+
 // The HandshakeNotifier interface is implemented by Handlers
 // that allow detecting peers that have passed the CER/CEA
 // handshake.
@@ -5,14 +5,15 @@
 package sm
 
 import (
+	"net"
 	"testing"
 	"time"
 
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/diamtest"
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 func testResultCode(m *diam.Message, want uint32) bool {
@@ -120,3 +121,42 @@ func TestStateMachine(t *testing.T) {
 		t.Fatal("No RAR message received")
 	}
 }
+
+func TestStateMachine_DecodeError(t *testing.T) {
+	sm := New(serverSettings)
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	c, err := net.Dial("tcp", srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	m := diam.NewRequest(diam.CapabilitiesExchange, 0, dict.Default)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientSettings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, clientSettings.OriginRealm)
+	m.AddAVP(diam.NewAVP(9999, avp.Mbit, 0, datatype.Unsigned32(1)))
+	if _, err = m.WriteTo(c); err != nil {
+		t.Fatal(err)
+	}
+	c.SetReadDeadline(time.Now().Add(time.Second))
+	// The Failed-AVP of the answer cannot be decoded either.
+	a, err := diam.ReadMessage(c, dict.Default)
+	e, ok := err.(*diam.ErrDecodeAVP)
+	if !ok {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !testResultCode(a, diam.AVPUnsupported) {
+		t.Fatalf("Unexpected answer: %s", a)
+	}
+	if f := e.FailedAVP(); f == nil || f.Code != 9999 {
+		t.Fatalf("Unexpected Failed-AVP: %v", f)
+	}
+	select {
+	case err := <-sm.ErrorReports():
+		if _, ok := err.Error.(*diam.ErrDecodeAVP); !ok {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for error")
+	}
+}
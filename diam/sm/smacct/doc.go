@@ -0,0 +1,28 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package smacct provides the accounting state machine for clients,
+// as described in RFC 6733 section 8.2.
+//
+// A Session drives the Accounting-Record-Type and Accounting-Record-Number
+// of the records of a session, sends them using a function provided by
+// the application, sends interim records periodically, and buffers
+// records that could not be delivered according to the value of the
+// Accounting-Realtime-Required AVP.
+//
+// Example:
+//
+//	s := &smacct.Session{
+//		Send: func(r *smacct.Record) (uint32, error) {
+//			// Build and send the ACR, wait for the ACA.
+//			return resultCode, err
+//		},
+//		InterimInterval: time.Minute,
+//	}
+//	if err := s.Start(); err != nil {
+//		// Deny access.
+//	}
+//	...
+//	s.Stop()
+package smacct
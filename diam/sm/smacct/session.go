@@ -0,0 +1,366 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package smacct
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// State is the state of an accounting session.
+type State int
+
+// Accounting client states. See RFC 6733 section 8.2.
+const (
+	Idle     State = iota // No session in progress
+	PendingS              // Waiting for the answer to a START_RECORD
+	PendingE              // Waiting for the answer to an EVENT_RECORD
+	PendingB              // Waiting for the answer to a buffered record
+	Open                  // Session in progress
+	PendingI              // Waiting for the answer to an INTERIM_RECORD
+	PendingL              // Waiting for the answer to a STOP_RECORD
+)
+
+var stateName = map[State]string{
+	Idle:     "Idle",
+	PendingS: "PendingS",
+	PendingE: "PendingE",
+	PendingB: "PendingB",
+	Open:     "Open",
+	PendingI: "PendingI",
+	PendingL: "PendingL",
+}
+
+// String returns the name of the state.
+func (s State) String() string {
+	if name, ok := stateName[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// Values of the Accounting-Record-Type AVP. See RFC 6733 section 9.8.1.
+const (
+	EventRecord   = 1
+	StartRecord   = 2
+	InterimRecord = 3
+	StopRecord    = 4
+)
+
+// Values of the Accounting-Realtime-Required AVP.
+// See RFC 6733 section 9.8.7.
+const (
+	DeliverAndGrant = 1
+	GrantAndStore   = 2
+	GrantAndLose    = 3
+)
+
+var (
+	// ErrInvalidState is returned when an operation is not allowed
+	// in the current state of the session, e.g. Stop before Start.
+	ErrInvalidState = errors.New("invalid accounting session state")
+
+	// ErrMissingSendFunc is returned when the Session has no Send
+	// function.
+	ErrMissingSendFunc = errors.New("accounting session has no Send function")
+)
+
+// ErrFailedResultCode is returned when the answer to an accounting
+// record contains a Result-Code that is not success (2xxx).
+type ErrFailedResultCode struct {
+	Code uint32
+}
+
+// Error implements the error interface.
+func (e *ErrFailedResultCode) Error() string {
+	return fmt.Sprintf("failed Result-Code AVP: %d", e.Code)
+}
+
+// Record is an accounting record of a session.
+type Record struct {
+	Type   int32  // Accounting-Record-Type
+	Number uint32 // Accounting-Record-Number
+}
+
+// SendFunc sends an Accounting-Request for the record r and waits for
+// its answer. It returns the Result-Code of the answer, or an error if
+// the request could not be delivered or was not answered.
+type SendFunc func(r *Record) (resultCode uint32, err error)
+
+// Session is the client side of an accounting session.
+//
+// Send is required. The other fields are optional and must not be
+// changed after Start or Event is called. Send and OnError are called
+// without holding the lock of the session, so the session may be used
+// while waiting for an answer.
+type Session struct {
+	Send             SendFunc
+	InterimInterval  time.Duration // Interval between interim records, 0 disables them
+	RealtimeRequired int32         // Accounting-Realtime-Required, GrantAndStore if 0
+
+	// OnError is called when an interim record fails and, when
+	// RealtimeRequired is DeliverAndGrant, after the session is
+	// terminated because of it. It may call Stop.
+	OnError func(r *Record, err error)
+
+	mu       sync.Mutex
+	sent     *sync.Cond // Signaled when the state changes
+	state    State
+	number   uint32
+	buffer   []*Record
+	interimc chan struct{} // closed to stop the interim timer
+}
+
+// State returns the current state of the session.
+func (s *Session) State() State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
+
+// Buffered returns the records that could not be delivered and are
+// waiting to be sent by Flush.
+func (s *Session) Buffered() []*Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Record(nil), s.buffer...)
+}
+
+func (s *Session) realtimeRequired() int32 {
+	if s.RealtimeRequired == 0 {
+		return GrantAndStore
+	}
+	return s.RealtimeRequired
+}
+
+// setState sets the state of the session, and wakes up the calls
+// waiting in wait. Must be called with the lock held.
+func (s *Session) setState(state State) {
+	s.state = state
+	if s.sent != nil {
+		s.sent.Broadcast()
+	}
+}
+
+// wait waits until no interim or buffered record is being sent, which
+// happens in the background and must not fail the calls made in the
+// meantime. Must be called with the lock held.
+func (s *Session) wait() {
+	for s.state == PendingI || s.state == PendingB {
+		if s.sent == nil {
+			s.sent = sync.NewCond(&s.mu)
+		}
+		s.sent.Wait()
+	}
+}
+
+// newRecord returns the next record of the session. Must be called
+// with the lock held.
+func (s *Session) newRecord(typ int32) *Record {
+	r := &Record{Type: typ, Number: s.number}
+	s.number++
+	return r
+}
+
+// send sends the record r and returns an error when it is not delivered
+// or not answered with success. Must be called without the lock held.
+func (s *Session) send(r *Record) error {
+	if s.Send == nil {
+		return ErrMissingSendFunc
+	}
+	code, err := s.Send(r)
+	if err != nil {
+		return err
+	}
+	if code < 2000 || code >= 3000 {
+		return &ErrFailedResultCode{Code: code}
+	}
+	return nil
+}
+
+// failed handles a record that was not delivered according to the
+// Accounting-Realtime-Required policy, and reports whether access
+// can still be granted. Must be called with the lock held.
+func (s *Session) failed(r *Record) bool {
+	switch s.realtimeRequired() {
+	case DeliverAndGrant:
+		return false
+	case GrantAndStore:
+		s.buffer = append(s.buffer, r)
+	}
+	return true
+}
+
+// Start sends the START_RECORD of the session, and starts sending
+// interim records when InterimInterval is set. The records of the
+// session are numbered from 0.
+//
+// When the record is not delivered and RealtimeRequired is
+// DeliverAndGrant, Start returns the error and the session goes back
+// to Idle, so the service should not be granted. Otherwise the session
+// is opened, and the record is buffered when RealtimeRequired is
+// GrantAndStore.
+func (s *Session) Start() error {
+	s.mu.Lock()
+	s.wait()
+	if s.state != Idle {
+		s.mu.Unlock()
+		return ErrInvalidState
+	}
+	s.state = PendingS
+	s.number = 0
+	r := s.newRecord(StartRecord)
+	s.mu.Unlock()
+	err := s.send(r)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil && !s.failed(r) {
+		s.setState(Idle)
+		return err
+	}
+	s.setState(Open)
+	if s.InterimInterval > 0 {
+		s.interimc = make(chan struct{})
+		go s.interim(s.interimc)
+	}
+	return nil
+}
+
+// Event sends an EVENT_RECORD, numbered 0. The session must be Idle.
+//
+// Delivery failures are handled as in Start, and the session always
+// returns to Idle.
+func (s *Session) Event() error {
+	s.mu.Lock()
+	s.wait()
+	if s.state != Idle {
+		s.mu.Unlock()
+		return ErrInvalidState
+	}
+	s.state = PendingE
+	s.number = 0
+	r := s.newRecord(EventRecord)
+	s.mu.Unlock()
+	err := s.send(r)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setState(Idle)
+	if err != nil && !s.failed(r) {
+		return err
+	}
+	return nil
+}
+
+// Stop stops sending interim records and sends the STOP_RECORD of the
+// session, which then returns to Idle. When the record is not delivered
+// it is buffered if RealtimeRequired is GrantAndStore, and the error is
+// returned otherwise.
+func (s *Session) Stop() error {
+	s.mu.Lock()
+	s.wait()
+	if s.state != Open {
+		s.mu.Unlock()
+		return ErrInvalidState
+	}
+	s.stopInterim()
+	s.state = PendingL
+	r := s.newRecord(StopRecord)
+	s.mu.Unlock()
+	err := s.send(r)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setState(Idle)
+	if err != nil {
+		if s.realtimeRequired() == GrantAndStore {
+			s.buffer = append(s.buffer, r)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// Flush sends the buffered records in order, and stops at the first one
+// that is not delivered. The session must not be waiting for an answer.
+func (s *Session) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.wait()
+	if s.state != Idle && s.state != Open {
+		return ErrInvalidState
+	}
+	prev := s.state
+	s.state = PendingB
+	defer s.setState(prev)
+	for len(s.buffer) > 0 {
+		r := s.buffer[0]
+		s.mu.Unlock()
+		err := s.send(r)
+		s.mu.Lock()
+		if err != nil {
+			return err
+		}
+		s.buffer = s.buffer[1:]
+	}
+	return nil
+}
+
+// stopInterim stops the interim timer. Must be called with the lock held.
+func (s *Session) stopInterim() {
+	if s.interimc != nil {
+		close(s.interimc)
+		s.interimc = nil
+	}
+}
+
+// interim sends INTERIM_RECORDs every InterimInterval until stopc
+// is closed.
+func (s *Session) interim(stopc chan struct{}) {
+	ticker := time.NewTicker(s.InterimInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopc:
+			return
+		case <-ticker.C:
+			if !s.sendInterim(stopc) {
+				return
+			}
+		}
+	}
+}
+
+// sendInterim sends an INTERIM_RECORD and reports whether the session
+// remains open.
+func (s *Session) sendInterim(stopc chan struct{}) bool {
+	s.mu.Lock()
+	s.wait()
+	select {
+	case <-stopc:
+		s.mu.Unlock()
+		return false
+	default:
+	}
+	s.state = PendingI
+	r := s.newRecord(InterimRecord)
+	s.mu.Unlock()
+	err := s.send(r)
+	s.mu.Lock()
+	open := err == nil || s.failed(r)
+	if open {
+		s.setState(Open)
+	} else {
+		// Deliver and grant: the service must be terminated.
+		s.stopInterim()
+		s.setState(Idle)
+	}
+	s.mu.Unlock()
+	if err != nil && s.OnError != nil {
+		s.OnError(r, err)
+	}
+	return open
+}
@@ -0,0 +1,246 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package smacct
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testSender records the records it sends, and fails when fail is set.
+type testSender struct {
+	mu      sync.Mutex
+	fail    bool
+	records []Record
+}
+
+func (ts *testSender) send(r *Record) (uint32, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.fail {
+		return 0, errors.New("no answer")
+	}
+	ts.records = append(ts.records, *r)
+	return 2001, nil
+}
+
+func (ts *testSender) sent() []Record {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return append([]Record(nil), ts.records...)
+}
+
+func (ts *testSender) setFail(fail bool) {
+	ts.mu.Lock()
+	ts.fail = fail
+	ts.mu.Unlock()
+}
+
+func TestSession(t *testing.T) {
+	ts := &testSender{}
+	s := &Session{Send: ts.send}
+	if err := s.Stop(); err != ErrInvalidState {
+		t.Fatalf("Unexpected error. Want %v, have %v", ErrInvalidState, err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if s.State() != Open {
+		t.Fatalf("Unexpected state. Want Open, have %s", s.State())
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if s.State() != Idle {
+		t.Fatalf("Unexpected state. Want Idle, have %s", s.State())
+	}
+	if err := s.Event(); err != nil {
+		t.Fatal(err)
+	}
+	want := []Record{{StartRecord, 0}, {StopRecord, 1}, {EventRecord, 0}}
+	have := ts.sent()
+	if len(have) != len(want) {
+		t.Fatalf("Unexpected records. Want %v, have %v", want, have)
+	}
+	for n := range want {
+		if have[n] != want[n] {
+			t.Fatalf("Unexpected records. Want %v, have %v", want, have)
+		}
+	}
+}
+
+func TestSession_Interim(t *testing.T) {
+	ts := &testSender{}
+	s := &Session{Send: ts.send, InterimInterval: 10 * time.Millisecond}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(55 * time.Millisecond)
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	records := ts.sent()
+	if len(records) < 3 {
+		t.Fatalf("Unexpected # of records: %v", records)
+	}
+	for n, r := range records[1 : len(records)-1] {
+		if r.Type != InterimRecord {
+			t.Fatalf("Unexpected record %d: %v", n+1, r)
+		}
+	}
+	if r := records[len(records)-1]; r.Type != StopRecord {
+		t.Fatalf("Unexpected last record: %v", r)
+	}
+}
+
+func TestSession_GrantAndStore(t *testing.T) {
+	ts := &testSender{fail: true}
+	s := &Session{Send: ts.send}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(s.Buffered()); n != 2 {
+		t.Fatalf("Unexpected # of buffered records. Want 2, have %d", n)
+	}
+	if err := s.Flush(); err == nil {
+		t.Fatal("Flush succeeded with no answer")
+	}
+	ts.setFail(false)
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(s.Buffered()); n != 0 {
+		t.Fatalf("Unexpected # of buffered records. Want 0, have %d", n)
+	}
+	if n := len(ts.sent()); n != 2 {
+		t.Fatalf("Unexpected # of records sent. Want 2, have %d", n)
+	}
+}
+
+func TestSession_GrantAndLose(t *testing.T) {
+	ts := &testSender{fail: true}
+	s := &Session{Send: ts.send, RealtimeRequired: GrantAndLose}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if s.State() != Open {
+		t.Fatalf("Unexpected state. Want Open, have %s", s.State())
+	}
+	if n := len(s.Buffered()); n != 0 {
+		t.Fatalf("Unexpected # of buffered records. Want 0, have %d", n)
+	}
+}
+
+func TestSession_DeliverAndGrant(t *testing.T) {
+	ts := &testSender{fail: true}
+	s := &Session{Send: ts.send, RealtimeRequired: DeliverAndGrant}
+	if err := s.Start(); err == nil {
+		t.Fatal("Start succeeded with no answer")
+	}
+	if s.State() != Idle {
+		t.Fatalf("Unexpected state. Want Idle, have %s", s.State())
+	}
+}
+
+func TestSession_DeliverAndGrant_Interim(t *testing.T) {
+	ts := &testSender{}
+	errc := make(chan error, 1)
+	s := &Session{
+		Send:             ts.send,
+		RealtimeRequired: DeliverAndGrant,
+		InterimInterval:  10 * time.Millisecond,
+		OnError: func(r *Record, err error) {
+			errc <- err
+		},
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	ts.setFail(true)
+	select {
+	case <-errc:
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for interim record failure")
+	}
+	if err := s.Stop(); err != ErrInvalidState {
+		t.Fatalf("Session was not terminated: %v", err)
+	}
+}
+
+func TestSession_FailedResultCode(t *testing.T) {
+	s := &Session{
+		Send: func(r *Record) (uint32, error) {
+			return 5012, nil
+		},
+		RealtimeRequired: DeliverAndGrant,
+	}
+	err := s.Event()
+	if e, ok := err.(*ErrFailedResultCode); !ok || e.Code != 5012 {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestSession_Restart(t *testing.T) {
+	ts := &testSender{}
+	s := &Session{Send: ts.send}
+	for n := 0; n < 2; n++ {
+		if err := s.Start(); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []Record{{StartRecord, 0}, {StopRecord, 1}, {StartRecord, 0}, {StopRecord, 1}}
+	have := ts.sent()
+	if len(have) != len(want) {
+		t.Fatalf("Unexpected records. Want %v, have %v", want, have)
+	}
+	for n := range want {
+		if have[n] != want[n] {
+			t.Fatalf("Unexpected records. Want %v, have %v", want, have)
+		}
+	}
+}
+
+func TestSession_Unlocked(t *testing.T) {
+	var s *Session
+	stopc := make(chan error, 1)
+	s = &Session{
+		Send: func(r *Record) (uint32, error) {
+			if state := s.State(); r.Type == StartRecord && state != PendingS {
+				t.Errorf("Unexpected state. Want PendingS, have %s", state)
+			}
+			if r.Type == InterimRecord {
+				return 0, errors.New("no answer")
+			}
+			return 2001, nil
+		},
+		RealtimeRequired: GrantAndLose,
+		InterimInterval:  10 * time.Millisecond,
+		OnError: func(r *Record, err error) {
+			stopc <- s.Stop()
+		},
+	}
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-stopc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for Stop in OnError")
+	}
+	if s.State() != Idle {
+		t.Fatalf("Unexpected state. Want Idle, have %s", s.State())
+	}
+}
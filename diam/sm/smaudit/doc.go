@@ -0,0 +1,30 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package smaudit provides a tamper-evident, append-only log of the
+// capabilities exchanges (CER/CEA) handled by the state machine.
+//
+// Each entry records who connected, the identity they claimed, their
+// addresses, the applications negotiated and the TLS state of the
+// connection. Entries are chained by HMAC-SHA256 with a secret key, each
+// one covering the HMAC of the entry before it, so modifying, removing
+// or reordering entries is detected by Verify. Entries removed from the
+// end of the log are only detected by VerifyHead, with the Head of the
+// log recorded outside of it.
+//
+// Example:
+//
+//	f, err := os.OpenFile("ce-audit.log", os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	audit, err := smaudit.ResumeLog(f, f, key)
+//	if err != nil {
+//		log.Fatal(err) // The log has been tampered with.
+//	}
+//	settings := &sm.Settings{
+//		...
+//		AuditLog: audit,
+//	}
+package smaudit
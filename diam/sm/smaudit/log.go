@@ -0,0 +1,190 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package smaudit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Entry is a Record in the Log.
+//
+// Entries are written one per line, as the hex encoded HMAC-SHA256 of
+// the entry followed by a space and the entry in JSON. The HMAC covers
+// the JSON, which includes the HMAC of the previous entry.
+type Entry struct {
+	Seq  uint64 `json:"seq"`  // Sequence number, starting at 1
+	Prev string `json:"prev"` // Hash of the previous entry
+	Record
+}
+
+// Log is an append-only log of capabilities exchanges, with entries
+// chained by their HMACs. It is safe for concurrent use.
+type Log struct {
+	mu   sync.Mutex
+	w    io.Writer
+	key  []byte
+	seq  uint64
+	prev string
+}
+
+// NewLog returns a Log that writes entries to w, starting a new chain.
+// The entries are authenticated with key, which must be kept secret,
+// away from the log: anyone with the key can rewrite the log.
+func NewLog(w io.Writer, key []byte) *Log {
+	return &Log{w: w, key: key}
+}
+
+// ResumeLog verifies the entries of an existing log read from r with
+// key, and returns a Log that appends entries to w continuing its chain.
+func ResumeLog(w io.Writer, r io.Reader, key []byte) (*Log, error) {
+	last, err := verify(r, key)
+	if err != nil {
+		return nil, err
+	}
+	l := NewLog(w, key)
+	if last != nil {
+		l.seq, l.prev = last.Seq, last.hash
+	}
+	return l, nil
+}
+
+// Append adds the Record r to the log. Each entry is written to the
+// underlying io.Writer with a single Write call.
+func (l *Log) Append(r *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e := &Entry{Seq: l.seq + 1, Prev: l.prev, Record: *r}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	h := hash(l.key, b)
+	line := make([]byte, 0, len(h)+len(b)+2)
+	line = append(line, h...)
+	line = append(line, ' ')
+	line = append(line, b...)
+	line = append(line, '\n')
+	if _, err = l.w.Write(line); err != nil {
+		return err
+	}
+	l.seq, l.prev = e.Seq, h
+	return nil
+}
+
+// Head returns the sequence number and HMAC of the last entry of the
+// log. Removing entries from the end of the log leaves a valid chain,
+// which can only be detected by VerifyHead with a copy of the head kept
+// elsewhere, such as in a remote system log.
+func (l *Log) Head() (seq uint64, hash string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.seq, l.prev
+}
+
+// ErrTampered is returned by Verify when the log has been modified.
+type ErrTampered struct {
+	Line   int // Line number of the first invalid entry
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *ErrTampered) Error() string {
+	return fmt.Sprintf("audit log tampered at line %d: %s", e.Line, e.Reason)
+}
+
+// verified is an Entry with its hash.
+type verified struct {
+	Entry
+	hash string
+}
+
+// Verify reads the log from r and checks that the HMAC of every entry
+// is correct for key and that entries form an unbroken chain. It
+// returns the last entry, or nil for an empty log. An *ErrTampered is
+// returned if the log has been modified.
+//
+// Entries removed from the end of the log are not detected. See
+// VerifyHead.
+func Verify(r io.Reader, key []byte) (*Entry, error) {
+	last, err := verify(r, key)
+	if last == nil {
+		return nil, err
+	}
+	return &last.Entry, err
+}
+
+// VerifyHead verifies the log read from r as Verify does, and checks
+// that it ends with the entry seq with the HMAC hash, as returned by
+// Head. An *ErrTampered is returned if the log has been modified or
+// truncated.
+func VerifyHead(r io.Reader, key []byte, seq uint64, hash string) error {
+	last, err := verify(r, key)
+	if err != nil {
+		return err
+	}
+	if last == nil {
+		last = &verified{}
+	}
+	if last.Seq < seq {
+		return &ErrTampered{
+			Line:   int(last.Seq) + 1,
+			Reason: fmt.Sprintf("truncated at sequence %d of %d", last.Seq, seq),
+		}
+	}
+	if last.Seq != seq || last.hash != hash {
+		return &ErrTampered{Line: int(last.Seq), Reason: "head mismatch"}
+	}
+	return nil
+}
+
+func verify(r io.Reader, key []byte) (*verified, error) {
+	var last *verified
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for n := 1; s.Scan(); n++ {
+		fields := bytes.SplitN(s.Bytes(), []byte(" "), 2)
+		if len(fields) != 2 {
+			return last, &ErrTampered{Line: n, Reason: "malformed entry"}
+		}
+		h := hash(key, fields[1])
+		if h != string(fields[0]) {
+			return last, &ErrTampered{Line: n, Reason: "hash mismatch"}
+		}
+		e := &verified{hash: h}
+		if err := json.Unmarshal(fields[1], &e.Entry); err != nil {
+			return last, &ErrTampered{Line: n, Reason: err.Error()}
+		}
+		var seq uint64
+		var prev string
+		if last != nil {
+			seq, prev = last.Seq, last.hash
+		}
+		if e.Seq != seq+1 {
+			return last, &ErrTampered{
+				Line:   n,
+				Reason: fmt.Sprintf("unexpected sequence %d", e.Seq),
+			}
+		}
+		if e.Prev != prev {
+			return last, &ErrTampered{Line: n, Reason: "broken chain"}
+		}
+		last = e
+	}
+	return last, s.Err()
+}
+
+func hash(key, b []byte) string {
+	h := hmac.New(sha256.New, key)
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))
+}
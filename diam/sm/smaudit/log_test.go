@@ -0,0 +1,145 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package smaudit
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testKey = []byte("secret")
+
+func testLog(t *testing.T, n int) (*Log, *bytes.Buffer) {
+	var b bytes.Buffer
+	l := NewLog(&b, testKey)
+	for i := 0; i < n; i++ {
+		err := l.Append(&Record{
+			Time:        time.Unix(int64(i), 0).UTC(),
+			Command:     "CER",
+			ResultCode:  2001,
+			OriginHost:  "peer",
+			OriginRealm: "test",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return l, &b
+}
+
+func TestLogVerify(t *testing.T) {
+	l, b := testLog(t, 3)
+	last, err := Verify(bytes.NewReader(b.Bytes()), testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Seq != 3 || last.Time.Unix() != 2 {
+		t.Fatalf("Unexpected last entry: %#v", last)
+	}
+	seq, _ := l.Head()
+	if seq != 3 {
+		t.Fatalf("Unexpected head. Want 3, have %d", seq)
+	}
+	if last, err = Verify(strings.NewReader(""), testKey); last != nil || err != nil {
+		t.Fatalf("Unexpected result for empty log: %v, %v", last, err)
+	}
+}
+
+func TestLogTampered(t *testing.T) {
+	_, b := testLog(t, 3)
+	lines := strings.SplitAfter(b.String(), "\n")
+	for _, test := range []struct {
+		Name string
+		Log  string
+		Line int
+	}{
+		{"modified", lines[0] + strings.Replace(lines[1], "peer", "evil", 1) + lines[2], 2},
+		{"removed", lines[0] + lines[2], 2},
+		{"reordered", lines[1] + lines[0] + lines[2], 1},
+		{"malformed", lines[0] + "garbage\n", 2},
+	} {
+		_, err := Verify(strings.NewReader(test.Log), testKey)
+		e, ok := err.(*ErrTampered)
+		if !ok {
+			t.Fatalf("%s: unexpected error: %v", test.Name, err)
+		}
+		if e.Line != test.Line {
+			t.Fatalf("%s: unexpected line. Want %d, have %d",
+				test.Name, test.Line, e.Line)
+		}
+	}
+}
+
+func TestResumeLog(t *testing.T) {
+	l, b := testLog(t, 2)
+	_, head := l.Head()
+	var more bytes.Buffer
+	rl, err := ResumeLog(&more, bytes.NewReader(b.Bytes()), testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = rl.Append(&Record{Command: "CEA"}); err != nil {
+		t.Fatal(err)
+	}
+	last, err := Verify(io.MultiReader(b, &more), testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Seq != 3 || last.Prev != head {
+		t.Fatalf("Unexpected resumed entry: %#v", last)
+	}
+	if _, err = ResumeLog(&more, strings.NewReader("garbage\n"), testKey); err == nil {
+		t.Fatal("Tampered log was resumed")
+	}
+}
+
+func TestLogKey(t *testing.T) {
+	_, b := testLog(t, 2)
+	_, err := Verify(bytes.NewReader(b.Bytes()), []byte("other"))
+	if e, ok := err.(*ErrTampered); !ok || e.Line != 1 {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Entries rewritten without the key, with a plain SHA-256, fail.
+	var forged bytes.Buffer
+	NewLog(&forged, nil).Append(&Record{Command: "CER", OriginHost: "evil"})
+	_, err = Verify(&forged, testKey)
+	if e, ok := err.(*ErrTampered); !ok || e.Reason != "hash mismatch" {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestLogTruncated(t *testing.T) {
+	l, b := testLog(t, 3)
+	seq, head := l.Head()
+	if err := VerifyHead(bytes.NewReader(b.Bytes()), testKey, seq, head); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitAfter(b.String(), "\n")
+	truncated := lines[0] + lines[1]
+	// The chain of a truncated log is still valid.
+	last, err := Verify(strings.NewReader(truncated), testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Seq != 2 {
+		t.Fatalf("Unexpected last entry. Want 2, have %d", last.Seq)
+	}
+	err = VerifyHead(strings.NewReader(truncated), testKey, seq, head)
+	if e, ok := err.(*ErrTampered); !ok || e.Line != 3 {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	err = VerifyHead(strings.NewReader(""), testKey, seq, head)
+	if e, ok := err.(*ErrTampered); !ok || e.Line != 1 {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// A log with a different last entry.
+	err = VerifyHead(bytes.NewReader(b.Bytes()), testKey, seq, lines[0][:64])
+	if e, ok := err.(*ErrTampered); !ok || e.Reason != "head mismatch" {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
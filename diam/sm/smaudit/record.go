@@ -0,0 +1,100 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package smaudit
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// Record describes a capabilities exchange.
+type Record struct {
+	Time          time.Time `json:"time"`
+	Command       string    `json:"command"`               // CER or CEA
+	ResultCode    uint32    `json:"result_code,omitempty"` // Sent in or received with the CEA
+	LocalAddr     string    `json:"local_addr,omitempty"`
+	RemoteAddr    string    `json:"remote_addr,omitempty"`
+	OriginHost    string    `json:"origin_host"` // Identity claimed by the peer
+	OriginRealm   string    `json:"origin_realm"`
+	HostIPAddress []string  `json:"host_ip_address,omitempty"`
+	Applications  []uint32  `json:"applications,omitempty"` // Negotiated applications
+	TLS           *TLS      `json:"tls,omitempty"`          // Nil when not using TLS
+	Error         string    `json:"error,omitempty"`        // Why the exchange failed
+}
+
+// TLS describes the security negotiated on a connection.
+type TLS struct {
+	Version     uint16 `json:"version"`
+	CipherSuite uint16 `json:"cipher_suite"`
+	ServerName  string `json:"server_name,omitempty"`
+	PeerSubject string `json:"peer_subject,omitempty"` // Subject of the peer certificate
+
+	// IdentityVerified reports whether the peer certificate names
+	// the Origin-Host claimed by the peer. The certificate chain is
+	// verified by the TLS handshake according to the tls.Config.
+	IdentityVerified bool `json:"identity_verified"`
+}
+
+// NewRecord returns a Record of the CER or CEA m, received on c.
+// The caller sets ResultCode, Applications and Error according to
+// the outcome of the exchange.
+func NewRecord(c diam.Conn, m *diam.Message) *Record {
+	r := &Record{
+		Time:    time.Now().UTC(),
+		Command: "CER",
+	}
+	if m.Header.CommandFlags&diam.RequestFlag == 0 {
+		r.Command = "CEA"
+	}
+	if addr := c.LocalAddr(); addr != nil {
+		r.LocalAddr = addr.String()
+	}
+	if addr := c.RemoteAddr(); addr != nil {
+		r.RemoteAddr = addr.String()
+	}
+	for _, a := range m.AVP {
+		switch a.Code {
+		case avp.OriginHost:
+			r.OriginHost = identity(a.Data)
+		case avp.OriginRealm:
+			r.OriginRealm = identity(a.Data)
+		case avp.HostIPAddress:
+			if ip, ok := a.Data.(datatype.Address); ok {
+				r.HostIPAddress = append(r.HostIPAddress, net.IP(ip).String())
+			}
+		}
+	}
+	if state := c.TLS(); state != nil {
+		r.TLS = newTLS(state, r.OriginHost)
+	}
+	return r
+}
+
+func identity(t datatype.Type) string {
+	if id, ok := t.(datatype.DiameterIdentity); ok {
+		return string(id)
+	}
+	return ""
+}
+
+func newTLS(state *tls.ConnectionState, originHost string) *TLS {
+	t := &TLS{
+		Version:     state.Version,
+		CipherSuite: state.CipherSuite,
+		ServerName:  state.ServerName,
+	}
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		t.PeerSubject = cert.Subject.String()
+		t.IdentityVerified = originHost != "" &&
+			cert.VerifyHostname(originHost) == nil
+	}
+	return t
+}
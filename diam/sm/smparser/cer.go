@@ -5,8 +5,8 @@
 package smparser
 
 import (
-	"github.com/fiorix/go-diameter/diam"
-	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
 )
 
 // CER is a Capabilities-Exchange-Request message.
@@ -15,6 +15,10 @@ type CER struct {
 	OriginHost                  datatype.DiameterIdentity `avp:"Origin-Host"`
 	OriginRealm                 datatype.DiameterIdentity `avp:"Origin-Realm"`
 	OriginStateID               *diam.AVP                 `avp:"Origin-State-Id"`
+	VendorID                    datatype.Unsigned32       `avp:"Vendor-Id"`
+	ProductName                 datatype.UTF8String       `avp:"Product-Name"`
+	FirmwareRevision            datatype.Unsigned32       `avp:"Firmware-Revision"`
+	SupportedVendorID           []datatype.Unsigned32     `avp:"Supported-Vendor-Id"`
 	InbandSecurityID            *diam.AVP                 `avp:"Inband-Security-Id"`
 	AcctApplicationID           []*diam.AVP               `avp:"Acct-Application-Id"`
 	AuthApplicationID           []*diam.AVP               `avp:"Auth-Application-Id"`
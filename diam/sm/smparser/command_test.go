@@ -7,7 +7,7 @@ package smparser
 import (
 	"bytes"
 
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 func init() {
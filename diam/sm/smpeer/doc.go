@@ -8,7 +8,7 @@
 // Example:
 //
 //	func handleXYZ(c diam.Conn, m *diam.Message) {
-//		meta, ok := smpeer.FromContext(c.Context())
+//		meta, ok := smpeer.FromConn(c)
 //		if ok {
 //			log.Println(meta)
 //		}
@@ -5,39 +5,92 @@
 package smpeer
 
 import (
+	"crypto/tls"
+	"net"
+
 	"golang.org/x/net/context"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
 )
 
 type key int
 
 const metadataKey key = 0
 
+// RelayApplicationID is the application id advertised by relay agents,
+// which forward requests of any application. See RFC 6733 section 2.4.
+const RelayApplicationID uint32 = 0xffffffff
+
 // Metadata contains information about a diameter peer, acquired
 // during the CER/CEA handshake.
 type Metadata struct {
 	OriginHost   datatype.DiameterIdentity
 	OriginRealm  datatype.DiameterIdentity
-	Applications []uint32 // Acct or Auth IDs supported by the peer.
+	Applications []uint32 // Acct or Auth IDs advertised by the peer.
+
+	// OriginStateID is the Origin-State-Id advertised by the peer
+	// during the handshake, or zero when not present.
+	OriginStateID datatype.Unsigned32
+
+	// Connection details, set by SetConn.
+	Transport  string               // Network of the connection, e.g. "tcp"
+	LocalAddr  net.Addr             // Local address of the connection
+	RemoteAddr net.Addr             // Address of the peer
+	TLS        *tls.ConnectionState // TLS state, or nil when not using TLS
+}
+
+// SetConn sets the connection details of the Metadata from c.
+// The state machine sets them on every handshake.
+func (m *Metadata) SetConn(c diam.Conn) {
+	m.LocalAddr = c.LocalAddr()
+	m.RemoteAddr = c.RemoteAddr()
+	if m.RemoteAddr != nil {
+		m.Transport = m.RemoteAddr.Network()
+	}
+	m.TLS = c.TLS()
+}
+
+// Supports reports whether the application id is one of the
+// Applications advertised by the peer in its CER or CEA. It does not
+// check whether the local node supports the application as well. The
+// base protocol (0) is always supported, and a peer advertising the
+// relay application (0xffffffff) supports all applications.
+func (m *Metadata) Supports(appid uint32) bool {
+	if appid == 0 {
+		return true
+	}
+	for _, id := range m.Applications {
+		if id == appid || id == RelayApplicationID {
+			return true
+		}
+	}
+	return false
 }
 
 // FromCER creates a Metadata object from data in the CER.
 func FromCER(cer *smparser.CER) *Metadata {
-	return &Metadata{
+	meta := &Metadata{
 		OriginHost:   cer.OriginHost,
 		OriginRealm:  cer.OriginRealm,
 		Applications: cer.Applications(),
 	}
+	if cer.OriginStateID != nil {
+		if v, ok := cer.OriginStateID.Data.(datatype.Unsigned32); ok {
+			meta.OriginStateID = v
+		}
+	}
+	return meta
 }
 
 // FromCEA creates a Metadata object from data in the CEA.
 func FromCEA(cea *smparser.CEA) *Metadata {
 	return &Metadata{
-		OriginHost:   cea.OriginHost,
-		OriginRealm:  cea.OriginRealm,
-		Applications: cea.Applications(),
+		OriginHost:    cea.OriginHost,
+		OriginRealm:   cea.OriginRealm,
+		Applications:  cea.Applications(),
+		OriginStateID: datatype.Unsigned32(cea.OriginStateID),
 	}
 }
 
@@ -46,6 +99,12 @@ func NewContext(ctx context.Context, metadata *Metadata) context.Context {
 	return context.WithValue(ctx, metadataKey, metadata)
 }
 
+// FromConn extracts the Metadata object from the context of c, which
+// the state machine sets once the peer passes the handshake.
+func FromConn(c diam.Conn) (*Metadata, bool) {
+	return FromContext(c.Context())
+}
+
 // FromContext extracts a Metadata object from the context.
 func FromContext(ctx context.Context) (*Metadata, bool) {
 	meta, ok := ctx.Value(metadataKey).(*Metadata)
@@ -9,8 +9,8 @@ import (
 
 	"golang.org/x/net/context"
 
-	"github.com/fiorix/go-diameter/diam/datatype"
-	"github.com/fiorix/go-diameter/diam/sm/smparser"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/sm/smparser"
 )
 
 func TestFromCER(t *testing.T) {
@@ -39,10 +39,15 @@ func TestFromCER(t *testing.T) {
 
 func TestFromCEA(t *testing.T) {
 	cer := &smparser.CEA{
-		OriginHost:  datatype.DiameterIdentity("foobar"),
-		OriginRealm: datatype.DiameterIdentity("test"),
+		OriginHost:    datatype.DiameterIdentity("foobar"),
+		OriginRealm:   datatype.DiameterIdentity("test"),
+		OriginStateID: 10,
 	}
 	meta := FromCEA(cer)
+	if meta.OriginStateID != 10 {
+		t.Fatalf("Unexpected OriginStateID. Want 10, have %d",
+			meta.OriginStateID)
+	}
 	if meta.OriginHost != cer.OriginHost {
 		t.Fatalf("Unexpected OriginHost. Want %q, have %q",
 			cer.OriginHost, meta.OriginHost)
@@ -60,3 +65,21 @@ func TestFromCEA(t *testing.T) {
 		t.Fatalf("Unexpected Metadata. Want %#v, have %#v", meta, data)
 	}
 }
+
+func TestSupports(t *testing.T) {
+	meta := &Metadata{Applications: []uint32{0, 4}}
+	if !meta.Supports(4) {
+		t.Fatal("Application 4 not supported")
+	}
+	if meta.Supports(1) {
+		t.Fatal("Application 1 unexpectedly supported")
+	}
+	meta = &Metadata{Applications: []uint32{4}}
+	if !meta.Supports(0) {
+		t.Fatal("Base protocol not supported")
+	}
+	meta = &Metadata{Applications: []uint32{RelayApplicationID}}
+	if !meta.Supports(16777251) {
+		t.Fatal("Application 16777251 not supported by relay")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+// originStateID is the Origin-State-Id of this process, generated once
+// at start up. See RFC 6733 section 8.16 for details.
+var originStateID = datatype.Unsigned32(time.Now().Unix())
+
+// OriginStateID returns the Origin-State-Id used by the state machine in
+// CER, CEA, DWR and DWA messages. It is Settings.OriginStateID when set,
+// or a value generated once at process start up otherwise.
+//
+// Applications should use it in their own requests when the
+// Origin-State-Id AVP is included.
+func (sm *StateMachine) OriginStateID() datatype.Unsigned32 {
+	if sm.cfg.OriginStateID != 0 {
+		return sm.cfg.OriginStateID
+	}
+	return originStateID
+}
+
+// LoadOriginStateID returns a new Origin-State-Id that is greater than
+// the one persisted in filename by the previous run, and persists the
+// new value in the same file. The file is created if it does not exist.
+//
+// It can be used to set Settings.OriginStateID so the value increases
+// monotonically across restarts, even if the system clock goes back.
+// It fails, leaving the file untouched, when the value cannot increase
+// anymore because the previous one is 4294967295.
+func LoadOriginStateID(filename string) (datatype.Unsigned32, error) {
+	var prev uint64
+	b, err := ioutil.ReadFile(filename)
+	switch {
+	case err == nil:
+		prev, err = strconv.ParseUint(strings.TrimSpace(string(b)), 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Origin-State-Id in %s: %s", filename, err)
+		}
+	case !os.IsNotExist(err):
+		return 0, err
+	}
+	next := uint64(time.Now().Unix())
+	if next <= prev {
+		next = prev + 1
+	}
+	if next > math.MaxUint32 {
+		return 0, fmt.Errorf("Origin-State-Id in %s cannot be greater than %d",
+			filename, uint32(math.MaxUint32))
+	}
+	err = ioutil.WriteFile(filename, []byte(strconv.FormatUint(next, 10)+"\n"), 0644)
+	if err != nil {
+		return 0, err
+	}
+	return datatype.Unsigned32(next), nil
+}
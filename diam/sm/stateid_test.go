@@ -0,0 +1,92 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+)
+
+func TestOriginStateID(t *testing.T) {
+	sm := New(&Settings{})
+	if sm.OriginStateID() != originStateID {
+		t.Fatalf("Unexpected Origin-State-Id. Want %d, have %d",
+			originStateID, sm.OriginStateID())
+	}
+	sm = New(&Settings{OriginStateID: 42})
+	if sm.OriginStateID() != 42 {
+		t.Fatalf("Unexpected Origin-State-Id. Want 42, have %d",
+			sm.OriginStateID())
+	}
+}
+
+func TestLoadOriginStateID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stateid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "state")
+	first, err := LoadOriginStateID(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := LoadOriginStateID(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second <= first {
+		t.Fatalf("Origin-State-Id did not increase: %d <= %d", second, first)
+	}
+	// Simulate a clock that went back.
+	future := datatype.Unsigned32(1<<32 - 2)
+	if err = ioutil.WriteFile(fn, []byte("4294967294\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	third, err := LoadOriginStateID(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third != future+1 {
+		t.Fatalf("Unexpected Origin-State-Id. Want %d, have %d", future+1, third)
+	}
+}
+
+func TestLoadOriginStateID_Invalid(t *testing.T) {
+	f, err := ioutil.TempFile("", "stateid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("foobar")
+	f.Close()
+	if _, err = LoadOriginStateID(f.Name()); err == nil {
+		t.Fatal("Invalid Origin-State-Id file was loaded with no error")
+	}
+}
+
+func TestLoadOriginStateID_Max(t *testing.T) {
+	f, err := ioutil.TempFile("", "stateid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("4294967295\n")
+	f.Close()
+	if _, err = LoadOriginStateID(f.Name()); err == nil {
+		t.Fatal("Origin-State-Id was increased past 4294967295")
+	}
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "4294967295\n" {
+		t.Fatalf("Unexpected file content: %q", b)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import "github.com/fiorix/go-diameter/v2/diam"
+
+// HandleStaticAnswer registers a handler that answers the given command
+// with a fixed Result-Code, for example "CCR" with
+// DIAMETER_APPLICATION_UNSUPPORTED (3007). The special command "ALL"
+// answers any request that has no other handler.
+//
+// The answer carries the Session-Id of the request, if any, and the
+// Origin-Host and Origin-Realm of this node. Answers are not answered.
+// See also Settings.StaticAnswers.
+func (sm *StateMachine) HandleStaticAnswer(cmd string, code uint32) {
+	sm.HandleFunc(cmd, handleStaticAnswer(sm, code))
+}
+
+// handleStaticAnswer answers requests with the given Result-Code.
+func handleStaticAnswer(sm *StateMachine, code uint32) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		if m.Header.CommandFlags&diam.RequestFlag == 0 {
+			return
+		}
+		sm.writeResultCode(c, m, code)
+	}
+}
+
+// writeResultCode answers the request m with the given Result-Code,
+// setting the E-bit for protocol errors.
+func (sm *StateMachine) writeResultCode(c diam.Conn, m *diam.Message, code uint32) {
+	sm.writeResultError(c, m, &diam.ResultError{Code: code})
+}
+
+// writeResultError answers the request m with the Result-Code of e,
+// and its Error-Message and Failed-AVP when set.
+func (sm *StateMachine) writeResultError(c diam.Conn, m *diam.Message, e *diam.ResultError) {
+	a := m.ErrorAnswer(e, sm.cfg.OriginHost, sm.cfg.OriginRealm)
+	if _, err := a.WriteTo(c); err != nil {
+		sm.Error(&diam.ErrorReport{
+			Conn:    c,
+			Message: m,
+			Error:   err,
+		})
+	}
+}
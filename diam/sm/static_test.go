@@ -0,0 +1,60 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package sm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestStateMachine_StaticAnswers(t *testing.T) {
+	settings := *serverSettings
+	settings.StaticAnswers = map[string]uint32{
+		"CCR": diam.ApplicationUnsupported,
+	}
+	sm := New(&settings)
+	srv := diamtest.NewServer(sm, dict.Default)
+	defer srv.Close()
+	mc := make(chan *diam.Message, 1)
+	cli := &Client{
+		Handler: New(clientSettings),
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+		},
+	}
+	cli.Handler.HandleFunc("CCA", func(c diam.Conn, m *diam.Message) {
+		mc <- m
+	})
+	c, err := cli.Dial(srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if _, err = newCCR(ccInitialRequest).WriteTo(c); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-mc:
+		if !testResultCode(resp, diam.ApplicationUnsupported) {
+			t.Fatalf("Unexpected result code.\n%s", resp)
+		}
+		if resp.Header.CommandFlags&diam.ErrorFlag == 0 {
+			t.Fatalf("Missing E-bit in answer.\n%s", resp)
+		}
+		if _, err := resp.FindAVP(avp.SessionID); err != nil {
+			t.Fatalf("Missing Session-Id in answer.\n%s", resp)
+		}
+	case err := <-sm.ErrorReports():
+		t.Fatal(err)
+	case <-time.After(time.Second):
+		t.Fatal("No CCA received")
+	}
+}
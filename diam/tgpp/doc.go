@@ -0,0 +1,19 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package tgpp provides constants and helpers for 3GPP Diameter
+// applications.
+//
+// 3GPP applications report most errors in the Experimental-Result AVP,
+// with the 3GPP Vendor-Id and an Experimental-Result-Code. Those codes
+// overlap the values of the base protocol Result-Code AVP, so they must
+// only be interpreted along with the Vendor-Id:
+//
+//	switch {
+//	case vendor == tgpp.VendorID && tgpp.Retryable(code):
+//		// Try again later.
+//	case vendor == tgpp.VendorID:
+//		log.Printf("request failed: %s", tgpp.ResultName(code))
+//	}
+package tgpp
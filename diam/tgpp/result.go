@@ -0,0 +1,150 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package tgpp
+
+import "fmt"
+
+// VendorID is the Vendor-Id of 3GPP.
+const VendorID = 10415
+
+// 3GPP codes for the Experimental-Result-Code AVP.
+const (
+	// TS 29.229 (Cx/Dx) and TS 29.329 (Sh).
+	FirstRegistration                  = 2001
+	SubsequentRegistration             = 2002
+	UnregisteredService                = 2003
+	SuccessServerNameNotStored         = 2004
+	ErrorUserUnknown                   = 5001
+	ErrorIdentitiesDontMatch           = 5002
+	ErrorIdentityNotRegistered         = 5003
+	ErrorRoamingNotAllowed             = 5004
+	ErrorIdentityAlreadyRegistered     = 5005
+	ErrorAuthSchemeNotSupported        = 5006
+	ErrorInAssignmentType              = 5007
+	ErrorTooMuchData                   = 5008
+	ErrorNotSupportedUserData          = 5009
+	ErrorFeatureUnsupported            = 5011
+	ErrorServingNodeFeatureUnsupported = 5012
+	UserDataNotAvailable               = 4100
+	PriorUpdateInProgress              = 4101
+	ErrorUserDataNotRecognized         = 5100
+	ErrorOperationNotAllowed           = 5101
+	ErrorUserDataCannotBeRead          = 5102
+	ErrorUserDataCannotBeModified      = 5103
+	ErrorUserDataCannotBeNotified      = 5104
+	ErrorTransparentDataOutOfSync      = 5105
+	ErrorSubsDataAbsent                = 5106
+	ErrorNoSubscriptionToData          = 5107
+	ErrorDSAINotAvailable              = 5108
+
+	// TS 29.272 (S6a/S6d).
+	AuthenticationDataUnavailable = 4181
+	ErrorCamelSubscriptionPresent = 4182
+	ErrorUnknownEPSSubscription   = 5420
+	ErrorRATNotAllowed            = 5421
+	ErrorEquipmentUnknown         = 5422
+	ErrorUnknownServingNode       = 5423
+
+	// TS 29.212 (Gx).
+	PCCBearerEvent                  = 4141
+	BearerEvent                     = 4142
+	ANGWFailed                      = 4143
+	PendingTransaction              = 4144
+	ErrorInitialParameters          = 5140
+	ErrorTriggerEvent               = 5141
+	PCCRuleEvent                    = 5142
+	ErrorBearerNotAuthorized        = 5143
+	ErrorTrafficMappingInfoRejected = 5144
+	ErrorConflictingRequest         = 5147
+	ADCRuleEvent                    = 5148
+
+	// TS 29.214 (Rx).
+	InvalidServiceInformation             = 5061
+	FilterRestrictions                    = 5062
+	RequestedServiceNotAuthorized         = 5063
+	DuplicatedAFSession                   = 5064
+	IPCANSessionNotAvailable              = 5065
+	UnauthorizedNonEmergencySession       = 5066
+	UnauthorizedSponsoredDataConnectivity = 5067
+	TemporaryNetworkFailure               = 5068
+)
+
+var resultName = map[uint32]string{
+	FirstRegistration:                     "DIAMETER_FIRST_REGISTRATION",
+	SubsequentRegistration:                "DIAMETER_SUBSEQUENT_REGISTRATION",
+	UnregisteredService:                   "DIAMETER_UNREGISTERED_SERVICE",
+	SuccessServerNameNotStored:            "DIAMETER_SUCCESS_SERVER_NAME_NOT_STORED",
+	ErrorUserUnknown:                      "DIAMETER_ERROR_USER_UNKNOWN",
+	ErrorIdentitiesDontMatch:              "DIAMETER_ERROR_IDENTITIES_DONT_MATCH",
+	ErrorIdentityNotRegistered:            "DIAMETER_ERROR_IDENTITY_NOT_REGISTERED",
+	ErrorRoamingNotAllowed:                "DIAMETER_ERROR_ROAMING_NOT_ALLOWED",
+	ErrorIdentityAlreadyRegistered:        "DIAMETER_ERROR_IDENTITY_ALREADY_REGISTERED",
+	ErrorAuthSchemeNotSupported:           "DIAMETER_ERROR_AUTH_SCHEME_NOT_SUPPORTED",
+	ErrorInAssignmentType:                 "DIAMETER_ERROR_IN_ASSIGNMENT_TYPE",
+	ErrorTooMuchData:                      "DIAMETER_ERROR_TOO_MUCH_DATA",
+	ErrorNotSupportedUserData:             "DIAMETER_ERROR_NOT_SUPPORTED_USER_DATA",
+	ErrorFeatureUnsupported:               "DIAMETER_ERROR_FEATURE_UNSUPPORTED",
+	ErrorServingNodeFeatureUnsupported:    "DIAMETER_ERROR_SERVING_NODE_FEATURE_UNSUPPORTED",
+	UserDataNotAvailable:                  "DIAMETER_USER_DATA_NOT_AVAILABLE",
+	PriorUpdateInProgress:                 "DIAMETER_PRIOR_UPDATE_IN_PROGRESS",
+	ErrorUserDataNotRecognized:            "DIAMETER_ERROR_USER_DATA_NOT_RECOGNIZED",
+	ErrorOperationNotAllowed:              "DIAMETER_ERROR_OPERATION_NOT_ALLOWED",
+	ErrorUserDataCannotBeRead:             "DIAMETER_ERROR_USER_DATA_CANNOT_BE_READ",
+	ErrorUserDataCannotBeModified:         "DIAMETER_ERROR_USER_DATA_CANNOT_BE_MODIFIED",
+	ErrorUserDataCannotBeNotified:         "DIAMETER_ERROR_USER_DATA_CANNOT_BE_NOTIFIED",
+	ErrorTransparentDataOutOfSync:         "DIAMETER_ERROR_TRANSPARENT_DATA_OUT_OF_SYNC",
+	ErrorSubsDataAbsent:                   "DIAMETER_ERROR_SUBS_DATA_ABSENT",
+	ErrorNoSubscriptionToData:             "DIAMETER_ERROR_NO_SUBSCRIPTION_TO_DATA",
+	ErrorDSAINotAvailable:                 "DIAMETER_ERROR_DSAI_NOT_AVAILABLE",
+	AuthenticationDataUnavailable:         "DIAMETER_AUTHENTICATION_DATA_UNAVAILABLE",
+	ErrorCamelSubscriptionPresent:         "DIAMETER_ERROR_CAMEL_SUBSCRIPTION_PRESENT",
+	ErrorUnknownEPSSubscription:           "DIAMETER_ERROR_UNKNOWN_EPS_SUBSCRIPTION",
+	ErrorRATNotAllowed:                    "DIAMETER_ERROR_RAT_NOT_ALLOWED",
+	ErrorEquipmentUnknown:                 "DIAMETER_ERROR_EQUIPMENT_UNKNOWN",
+	ErrorUnknownServingNode:               "DIAMETER_ERROR_UNKNOWN_SERVING_NODE",
+	PCCBearerEvent:                        "DIAMETER_PCC_BEARER_EVENT",
+	BearerEvent:                           "DIAMETER_BEARER_EVENT",
+	ANGWFailed:                            "DIAMETER_AN_GW_FAILED",
+	PendingTransaction:                    "DIAMETER_PENDING_TRANSACTION",
+	ErrorInitialParameters:                "DIAMETER_ERROR_INITIAL_PARAMETERS",
+	ErrorTriggerEvent:                     "DIAMETER_ERROR_TRIGGER_EVENT",
+	PCCRuleEvent:                          "DIAMETER_PCC_RULE_EVENT",
+	ErrorBearerNotAuthorized:              "DIAMETER_ERROR_BEARER_NOT_AUTHORIZED",
+	ErrorTrafficMappingInfoRejected:       "DIAMETER_ERROR_TRAFFIC_MAPPING_INFO_REJECTED",
+	ErrorConflictingRequest:               "DIAMETER_ERROR_CONFLICTING_REQUEST",
+	ADCRuleEvent:                          "DIAMETER_ADC_RULE_EVENT",
+	InvalidServiceInformation:             "INVALID_SERVICE_INFORMATION",
+	FilterRestrictions:                    "FILTER_RESTRICTIONS",
+	RequestedServiceNotAuthorized:         "REQUESTED_SERVICE_NOT_AUTHORIZED",
+	DuplicatedAFSession:                   "DUPLICATED_AF_SESSION",
+	IPCANSessionNotAvailable:              "IP-CAN_SESSION_NOT_AVAILABLE",
+	UnauthorizedNonEmergencySession:       "UNAUTHORIZED_NON_EMERGENCY_SESSION",
+	UnauthorizedSponsoredDataConnectivity: "UNAUTHORIZED_SPONSORED_DATA_CONNECTIVITY",
+	TemporaryNetworkFailure:               "TEMPORARY_NETWORK_FAILURE",
+}
+
+// ResultName returns the name of a 3GPP Experimental-Result-Code, as
+// in the 3GPP specifications, e.g. "DIAMETER_ERROR_USER_UNKNOWN".
+// Unknown codes are named after their number.
+func ResultName(code uint32) string {
+	if name, ok := resultName[code]; ok {
+		return name
+	}
+	return fmt.Sprintf("EXPERIMENTAL_RESULT_%d", code)
+}
+
+// Retryable reports whether a request answered with the 3GPP
+// Experimental-Result-Code may succeed if sent again later.
+//
+// Following RFC 6733 section 7.1, codes in the 4xxx range are
+// transient failures. TEMPORARY_NETWORK_FAILURE is also retryable,
+// despite being in the 5xxx range. Other codes, including successes,
+// are not.
+func Retryable(code uint32) bool {
+	if code == TemporaryNetworkFailure {
+		return true
+	}
+	return code >= 4000 && code < 5000
+}
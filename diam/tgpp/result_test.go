@@ -0,0 +1,36 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package tgpp
+
+import "testing"
+
+func TestResultName(t *testing.T) {
+	for code, want := range map[uint32]string{
+		ErrorUserUnknown:              "DIAMETER_ERROR_USER_UNKNOWN",
+		AuthenticationDataUnavailable: "DIAMETER_AUTHENTICATION_DATA_UNAVAILABLE",
+		9999:                          "EXPERIMENTAL_RESULT_9999",
+	} {
+		if have := ResultName(code); have != want {
+			t.Fatalf("Unexpected name of %d. Want %s, have %s", code, want, have)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	for code, want := range map[uint32]bool{
+		FirstRegistration:             false,
+		AuthenticationDataUnavailable: true,
+		PriorUpdateInProgress:         true,
+		PendingTransaction:            true,
+		TemporaryNetworkFailure:       true,
+		ErrorUserUnknown:              false,
+		ErrorRATNotAllowed:            false,
+	} {
+		if have := Retryable(code); have != want {
+			t.Fatalf("Unexpected Retryable(%s). Want %t, have %t",
+				ResultName(code), want, have)
+		}
+	}
+}
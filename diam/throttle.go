@@ -0,0 +1,50 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Throttled returns the number of incoming connections closed because
+// the server had MaxHandshakes connections in the handshake already.
+func (srv *Server) Throttled() uint64 {
+	return atomic.LoadUint64(&srv.throttled)
+}
+
+// beginHandshake reserves a handshake slot for a new connection. It
+// returns false when all MaxHandshakes slots are taken.
+func (srv *Server) beginHandshake() bool {
+	if srv.MaxHandshakes <= 0 {
+		return true
+	}
+	if atomic.AddInt64(&srv.pending, 1) > int64(srv.MaxHandshakes) {
+		atomic.AddInt64(&srv.pending, -1)
+		return false
+	}
+	return true
+}
+
+// endHandshake releases a slot taken by beginHandshake.
+func (srv *Server) endHandshake() {
+	if srv.MaxHandshakes > 0 {
+		atomic.AddInt64(&srv.pending, -1)
+	}
+}
+
+// endHandshake releases the handshake slot of the connection, once its
+// first message has been read or it is closed, whichever comes first.
+func (c *conn) endHandshake() {
+	if c.shaking {
+		c.shaking = false
+		c.server.endHandshake()
+		if c.server.HandshakeTimeout > 0 && c.server.ReadTimeout == 0 {
+			// Clear the deadline of the handshake, which readMessage
+			// does not replace without a ReadTimeout.
+			c.rwc.SetReadDeadline(time.Time{})
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v2/diam"
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/diamtest"
+)
+
+func TestServer_MaxHandshakes(t *testing.T) {
+	errc := make(chan error, 1)
+	smux := diam.NewServeMux()
+	smux.Handle("CER", handleCER(errc, false))
+	srv := diamtest.NewUnstartedServer(smux, nil)
+	srv.Config.MaxHandshakes = 1
+	srv.Start()
+	defer srv.Close()
+	// Take the only handshake slot without sending a CER.
+	idle, err := net.Dial("tcp", srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := net.Dial("tcp", srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err = c.Read(make([]byte, 1)); err == nil {
+		t.Fatal("Connection was not closed by the server")
+	}
+	if n := srv.Config.Throttled(); n != 1 {
+		t.Fatalf("Unexpected # of throttled connections. Want 1, have %d", n)
+	}
+	// Closing the idle connection releases its slot.
+	idle.Close()
+	wait := make(chan struct{})
+	cmux := diam.NewServeMux()
+	cmux.Handle("CEA", handleCEA(errc, wait))
+	for i := 0; ; i++ {
+		cli, err := diam.Dial(srv.Address, cmux, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer cli.Close()
+		sendCER(cli)
+		select {
+		case <-wait:
+			return
+		case err := <-errc:
+			t.Fatal(err)
+		case <-time.After(100 * time.Millisecond):
+			if i == 10 {
+				t.Fatal("Timed out: no CER or CEA received")
+			}
+		}
+	}
+}
+
+func TestServer_HandshakeTimeout(t *testing.T) {
+	srv := diamtest.NewUnstartedServer(diam.NewServeMux(), nil)
+	srv.Config.MaxHandshakes = 1
+	srv.Config.HandshakeTimeout = 50 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+	c, err := net.Dial("tcp", srv.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	c.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err = c.Read(make([]byte, 1)); err == nil {
+		t.Fatal("Connection was not closed by the server")
+	}
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		t.Fatal("Connection was not closed by the server")
+	}
+}
+
+func TestServer_HandshakeTimeoutCleared(t *testing.T) {
+	smux := diam.NewServeMux()
+	smux.HandleFunc("DWR", func(c diam.Conn, m *diam.Message) {
+		a := m.Answer(diam.Success)
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("srv"))
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("localhost"))
+		a.WriteTo(c)
+	})
+	srv := diamtest.NewUnstartedServer(smux, nil)
+	srv.Config.HandshakeTimeout = 50 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+	dwa := make(chan struct{}, 2)
+	cmux := diam.NewServeMux()
+	cmux.HandleFunc("DWA", func(c diam.Conn, m *diam.Message) {
+		dwa <- struct{}{}
+	})
+	cli, err := diam.Dial(srv.Address, cmux, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	for n := 0; n < 2; n++ {
+		if n > 0 {
+			// Past the handshake timeout, which must not apply anymore.
+			time.Sleep(150 * time.Millisecond)
+		}
+		m := diam.NewRequest(diam.DeviceWatchdog, 0, nil)
+		m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("cli"))
+		m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("localhost"))
+		if _, err := m.WriteTo(cli); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-dwa:
+		case <-time.After(time.Second):
+			t.Fatalf("No DWA received for DWR #%d", n+1)
+		}
+	}
+}
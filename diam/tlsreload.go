@@ -0,0 +1,131 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"crypto/tls"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader holds a TLS certificate and private key loaded from
+// disk, and reloads them on demand, so certificates can be rotated
+// without restarting the server or dropping established connections.
+// Connections keep the certificate they were established with, and
+// only new handshakes use the reloaded one.
+//
+// Use it in tls.Config:
+//
+//	r, err := diam.NewCertReloader("cert.pem", "key.pem")
+//	srv.TLSConfig = &tls.Config{GetCertificate: r.GetCertificate}
+//	srv.ListenAndServeTLS("", "")
+//
+// Then call Reload, for example on SIGHUP, or Watch for file changes.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.RWMutex // guards the following
+	cert    *tls.Certificate
+	modTime time.Time // newest modification time of the files
+}
+
+// NewCertReloader loads the certificate and private key from the
+// given files, and returns a CertReloader for them.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the certificate and private key from disk. On error,
+// the previous certificate is kept.
+func (r *CertReloader) Reload() error {
+	modTime, err := r.filesModTime()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.modTime = modTime
+	r.mu.Unlock()
+	return nil
+}
+
+// filesModTime returns the newest modification time of the files.
+func (r *CertReloader) filesModTime() (time.Time, error) {
+	var t time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return t, err
+		}
+		if fi.ModTime().After(t) {
+			t = fi.ModTime()
+		}
+	}
+	return t, nil
+}
+
+// Watch checks the files for changes at every interval, and reloads
+// them when they change. Errors are logged, and the previous
+// certificate is kept. Watch returns when stop is closed.
+func (r *CertReloader) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		modTime, err := r.filesModTime()
+		if err != nil {
+			log.Printf("diam: certificate reload error: %v", err)
+			continue
+		}
+		r.mu.RLock()
+		changed := modTime.After(r.modTime)
+		r.mu.RUnlock()
+		if !changed {
+			continue
+		}
+		if err = r.Reload(); err != nil {
+			log.Printf("diam: certificate reload error: %v", err)
+		}
+	}
+}
+
+// Certificate returns the current certificate.
+func (r *CertReloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// GetCertificate returns the current certificate. It is meant to be
+// used as the GetCertificate function of a server tls.Config.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// GetClientCertificate returns the current certificate. It is meant to
+// be used as the GetClientCertificate function of a client tls.Config.
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// clientSessionCache is used by DialTLS when the TLS configuration has
+// no ClientSessionCache, so that reconnections to the same peer can
+// resume their TLS sessions.
+var clientSessionCache = tls.NewLRUClientSessionCache(0)
@@ -0,0 +1,122 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCert writes a new self-signed certificate and its key to
+// the given files, and returns the DER encoded certificate.
+func writeTestCert(t *testing.T, certFile, keyFile string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err = ioutil.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func TestCertReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	der := writeTestCert(t, certFile, keyFile)
+	r, err := NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := r.GetCertificate(nil); !bytes.Equal(c.Certificate[0], der) {
+		t.Fatal("Unexpected certificate after loading")
+	}
+
+	der = writeTestCert(t, certFile, keyFile)
+	if err = r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := r.GetClientCertificate(nil); !bytes.Equal(c.Certificate[0], der) {
+		t.Fatal("Unexpected certificate after reloading")
+	}
+
+	// Invalid files keep the previous certificate.
+	if err = ioutil.WriteFile(keyFile, []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Reload(); err == nil {
+		t.Fatal("Unexpected success reloading an invalid key")
+	}
+	if c := r.Certificate(); !bytes.Equal(c.Certificate[0], der) {
+		t.Fatal("Unexpected certificate after failing to reload")
+	}
+}
+
+func TestCertReloaderWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	writeTestCert(t, certFile, keyFile)
+	r, err := NewCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go r.Watch(10*time.Millisecond, stop)
+
+	der := writeTestCert(t, certFile, keyFile)
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, future, future)
+	timeout := time.After(time.Second)
+	for !bytes.Equal(r.Certificate().Certificate[0], der) {
+		select {
+		case <-timeout:
+			t.Fatal("Timed out: certificate not reloaded")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
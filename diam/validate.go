@@ -0,0 +1,235 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+// Validate checks the message against the rules of its command in the
+// dictionary, or in the dictionary of the message when nil. It returns
+// a *ResultError for the first problem found, with the Result-Code and
+// Failed-AVP for the error answer, per RFC 6733 section 7:
+//
+//   - DIAMETER_COMMAND_UNSUPPORTED when the command is not in the dictionary
+//   - DIAMETER_INVALID_HDR_BITS for requests with the E-bit set
+//   - DIAMETER_AVP_UNSUPPORTED for unknown AVPs with the M-bit set
+//   - DIAMETER_INVALID_AVP_BITS for AVP flags that contradict the dictionary
+//   - DIAMETER_AVP_NOT_ALLOWED for AVPs the rules do not allow, and for
+//     a Session-Id that is not the first AVP
+//   - DIAMETER_MISSING_AVP for required AVPs, with an example of the
+//     missing AVP, as zeroes
+//   - DIAMETER_AVP_OCCURS_TOO_MANY_TIMES, with the first AVP over the max
+//
+// The children of Grouped AVPs are checked against the rules of the
+// group. For problems in a Grouped AVP, the Failed-AVP holds a copy of
+// it with only the offending AVP. Unknown AVPs without the M-bit are
+// ignored.
+func (m *Message) Validate(dictionary *dict.Parser) error {
+	var first *ValidationError
+	m.validate(dictionary, func(e *ValidationError) bool {
+		first = e
+		return false
+	})
+	if first == nil {
+		return nil
+	}
+	re := *first.ResultError
+	re.Message = first.Error()
+	return &re
+}
+
+// ValidateAll checks the message as Validate does, and returns all the
+// problems found rather than the first one.
+func (m *Message) ValidateAll(dictionary *dict.Parser) []*ValidationError {
+	var all []*ValidationError
+	m.validate(dictionary, func(e *ValidationError) bool {
+		all = append(all, e)
+		return true
+	})
+	return all
+}
+
+// ValidationError is a problem found by ValidateAll or
+// GroupedAVP.Validate, with the Result-Code and Failed-AVP of the
+// error answer.
+type ValidationError struct {
+	Path string // Command and Grouped AVPs, e.g. CCR/Subscription-Id
+	*ResultError
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// validator checks AVPs against the rules of the dictionary, and calls
+// report for each problem found until it returns false.
+type validator struct {
+	d      *dict.Parser
+	appid  uint32
+	report func(e *ValidationError) bool
+}
+
+// problem reports a problem found in path, and whether to go on.
+func (v *validator) problem(path string, code uint32, msg string, failed *AVP) bool {
+	e := &ValidationError{
+		Path:        path,
+		ResultError: &ResultError{Code: code, Message: msg},
+	}
+	if failed != nil {
+		e.FailedAVP = []*AVP{failed}
+	}
+	return v.report(e)
+}
+
+func (m *Message) validate(dictionary *dict.Parser, report func(e *ValidationError) bool) {
+	if dictionary == nil {
+		dictionary = m.Dictionary()
+	}
+	v := &validator{d: dictionary, appid: m.Header.ApplicationID, report: report}
+	cmd, err := dictionary.FindCommand(m.Header.ApplicationID, m.Header.CommandCode)
+	if err != nil {
+		v.problem(fmt.Sprintf("command %d", m.Header.CommandCode), CommandUnsupported, err.Error(), nil)
+		return
+	}
+	path := cmd.Short + "A"
+	rules := cmd.Answer.Rule
+	if m.Header.CommandFlags&RequestFlag != 0 {
+		path = cmd.Short + "R"
+		rules = cmd.Request.Rule
+		if m.Header.CommandFlags&ErrorFlag != 0 &&
+			!v.problem(path, InvalidHDRBits, "E-bit set in request", nil) {
+			return
+		}
+	}
+	for n, a := range m.AVP {
+		if a.Code == avp.SessionID && n > 0 &&
+			!v.problem(path, AVPNotAllowed, "Session-Id is not the first AVP", a) {
+			return
+		}
+	}
+	v.validateAVPs(path, rules, m.AVP)
+}
+
+// validateAVPs checks avps against rules, and the children of Grouped
+// AVPs against the rules of the group. It reports whether to go on.
+func (v *validator) validateAVPs(path string, rules []*dict.Rule, avps []*AVP) bool {
+	allowed := make(map[string]*dict.Rule, len(rules))
+	for _, rule := range rules {
+		allowed[rule.AVP] = rule
+	}
+	found := make(map[string][]*AVP)
+	for _, a := range avps {
+		dictAVP, err := v.d.FindAVPWithVendor(v.appid, a.Code, a.VendorID)
+		if err != nil {
+			if a.Flags&avp.Mbit == 0 {
+				continue
+			}
+			msg := fmt.Sprintf("unknown AVP %d (vendor %d)", a.Code, a.VendorID)
+			if !v.problem(path, AVPUnsupported, msg, a) {
+				return false
+			}
+			continue
+		}
+		found[dictAVP.Name] = append(found[dictAVP.Name], a)
+		if msg := checkAVPFlags(a, dictAVP); msg != "" &&
+			!v.problem(path+"/"+dictAVP.Name, InvalidAVPBits, msg, a) {
+			return false
+		}
+		rule := allowed[dictAVP.Name]
+		if len(rules) > 0 && (rule == nil && allowed["AVP"] == nil || rule != nil && rule.Forbidden) &&
+			!v.problem(path, AVPNotAllowed, fmt.Sprintf("AVP %s is not allowed", dictAVP.Name), a) {
+			return false
+		}
+		if g, ok := a.Data.(*GroupedAVP); ok {
+			group := &validator{d: v.d, appid: v.appid, report: func(e *ValidationError) bool {
+				e.FailedAVP = []*AVP{NewAVP(a.Code, a.Flags, a.VendorID, &GroupedAVP{AVP: e.FailedAVP})}
+				return v.report(e)
+			}}
+			if !group.validateAVPs(path+"/"+dictAVP.Name, dictAVP.Data.Rule, g.AVP) {
+				return false
+			}
+		}
+	}
+	for _, rule := range rules {
+		if rule.AVP == "AVP" || rule.Forbidden {
+			continue
+		}
+		min := rule.Min
+		if rule.Required && min < 1 {
+			min = 1
+		}
+		l := found[rule.AVP]
+		var ok bool
+		switch {
+		case len(l) < min:
+			msg := fmt.Sprintf("missing required AVP %s", rule.AVP)
+			if len(l) > 0 {
+				msg = fmt.Sprintf("AVP %s occurs %d times, min %d", rule.AVP, len(l), min)
+			}
+			var failed *AVP
+			if dictAVP, err := v.d.FindAVP(v.appid, rule.AVP); err == nil {
+				failed = exampleAVP(dictAVP)
+			}
+			ok = v.problem(path, MissingAVP, msg, failed)
+		case rule.Max > 0 && len(l) > rule.Max:
+			msg := fmt.Sprintf("AVP %s occurs %d times, max %d", rule.AVP, len(l), rule.Max)
+			ok = v.problem(path, AVPOccursTooManyTimes, msg, l[rule.Max])
+		default:
+			ok = true
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+var avpFlagBits = []struct {
+	name string
+	bit  uint8
+}{
+	{"V", avp.Vbit},
+	{"M", avp.Mbit},
+	{"P", avp.Pbit},
+}
+
+// checkAVPFlags checks the flags and vendor of a against the dictionary,
+// and describes the first problem found.
+func checkAVPFlags(a *AVP, dictAVP *dict.AVP) string {
+	for _, f := range avpFlagBits {
+		set := a.Flags&f.bit != 0
+		switch {
+		case !set && strings.Contains(dictAVP.Must, f.name):
+			return fmt.Sprintf("%s-bit must be set", f.name)
+		case set && strings.Contains(dictAVP.MustNot, f.name):
+			return fmt.Sprintf("%s-bit must not be set", f.name)
+		}
+	}
+	if a.Flags&avp.Vbit != 0 && dictAVP.VendorID != 0 && a.VendorID != dictAVP.VendorID {
+		return fmt.Sprintf("vendor %d, want %d", a.VendorID, dictAVP.VendorID)
+	}
+	return ""
+}
+
+// exampleAVP returns an example of the dictionary AVP for the Failed-AVP
+// of DIAMETER_MISSING_AVP answers, with its data as zeroes.
+func exampleAVP(dictAVP *dict.AVP) *AVP {
+	var data datatype.Type = datatype.OctetString("")
+	switch t, ok := marshalTypes[dictAVP.Data.Type]; {
+	case dictAVP.Data.Type == datatype.GroupedType:
+		data = &GroupedAVP{}
+	case ok:
+		data = reflect.Zero(t).Interface().(datatype.Type)
+	}
+	return NewAVP(dictAVP.Code, dictFlags(dictAVP), dictAVP.VendorID, data)
+}
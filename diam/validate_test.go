@@ -0,0 +1,88 @@
+// Copyright 2013-2015 go-diameter authors. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package diam
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fiorix/go-diameter/v2/diam/avp"
+	"github.com/fiorix/go-diameter/v2/diam/datatype"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
+)
+
+func TestMessageValidate(t *testing.T) {
+	m, err := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Validate(nil); err != nil {
+		t.Fatal(err)
+	}
+	originHost := NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("test"))
+	vsa := NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &GroupedAVP{
+		AVP: []*AVP{
+			NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4)),
+			NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(10415)),
+			originHost,
+		},
+	})
+	for _, tc := range []struct {
+		name   string
+		change func(m *Message)
+		code   uint32
+		failed *AVP
+	}{
+		{
+			"missing AVP",
+			func(m *Message) { m.AVP = m.AVP[1:] },
+			MissingAVP,
+			NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("")),
+		},
+		{
+			"too many AVPs",
+			func(m *Message) { m.AddAVP(originHost) },
+			AVPOccursTooManyTimes,
+			originHost,
+		},
+		{
+			"M-bit not set",
+			func(m *Message) { m.AVP[0].Flags = 0 },
+			InvalidAVPBits,
+			NewAVP(avp.OriginHost, 0, 0, datatype.DiameterIdentity("test")),
+		},
+		{
+			"unknown AVP",
+			func(m *Message) { m.AddAVP(NewAVP(9999, avp.Mbit, 0, datatype.Unsigned32(1))) },
+			AVPUnsupported,
+			NewAVP(9999, avp.Mbit, 0, datatype.Unsigned32(1)),
+		},
+		{
+			"AVP not allowed in group",
+			func(m *Message) { m.AVP[10] = vsa },
+			AVPNotAllowed,
+			NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &GroupedAVP{AVP: []*AVP{originHost}}),
+		},
+		{
+			"Session-Id not first",
+			func(m *Message) { m.AddAVP(NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("s"))) },
+			AVPNotAllowed,
+			NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String("s")),
+		},
+	} {
+		m, _ := ReadMessage(bytes.NewReader(testMessage), dict.Default)
+		tc.change(m)
+		e, ok := m.Validate(nil).(*ResultError)
+		if !ok {
+			t.Fatalf("%s: unexpected error: %v", tc.name, m.Validate(nil))
+		}
+		if e.Code != tc.code {
+			t.Fatalf("%s: unexpected Result-Code. Want %d, have %d (%s)", tc.name, tc.code, e.Code, e.Message)
+		}
+		if len(e.FailedAVP) != 1 || !e.FailedAVP[0].Equal(tc.failed) {
+			t.Fatalf("%s: unexpected Failed-AVP.\nWant: %s\nHave: %s", tc.name, tc.failed, e.FailedAVP)
+		}
+	}
+}
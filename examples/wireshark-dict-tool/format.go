@@ -53,10 +53,11 @@ type DataType struct {
 	Name string `xml:"type-name,attr"`
 }
 
-// Enum contains the code and name of Enumerated items.
+// Enum contains the code and name of Enumerated items. Some values are
+// written unsigned, e.g. 4294967295 for -1.
 type Enum struct {
 	Name string `xml:"name,attr"`
-	Code uint8  `xml:"code,attr"`
+	Code int64  `xml:"code,attr"`
 }
 
 // Grouped represents a grouped AVP definition.
@@ -18,7 +18,7 @@ import (
 	"log"
 	"os"
 
-	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/v2/diam/dict"
 )
 
 func main() {
@@ -120,7 +120,7 @@ func copyAvps(src []*AVP, dst *dict.App) {
 			newAVP.Data.Enum = append(newAVP.Data.Enum,
 				&dict.Enum{
 					Name: p.Name,
-					Code: p.Code,
+					Code: int32(p.Code),
 				})
 		}
 		for _, grp := range avp.Grouped {
@@ -0,0 +1,8 @@
+module github.com/fiorix/go-diameter/v2
+
+go 1.18
+
+require (
+	golang.org/x/net v0.24.0
+	gopkg.in/yaml.v3 v3.0.1
+)